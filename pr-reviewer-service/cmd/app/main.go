@@ -3,16 +3,27 @@ package main
 import (
 	"context"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"pr-reviewer-service/internal/auth"
 	"pr-reviewer-service/internal/db"
-	"pr-reviewer-service/internal/handlers"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/graphqlapi"
+	"pr-reviewer-service/internal/grpcapi"
+	"pr-reviewer-service/internal/httpapi"
 	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/notify"
 	"pr-reviewer-service/internal/repositories"
+	"pr-reviewer-service/internal/scheduler"
 	"pr-reviewer-service/internal/services"
+	"pr-reviewer-service/internal/tracing"
 	"syscall"
 	"time"
+	_ "time/tzdata" // часовые пояса пользователей: в alpine-образе нет zoneinfo
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
@@ -25,14 +36,15 @@ func init() {
 }
 
 func main() {
+	cfg := config.Load()
+
 	// Инициализация логгера
-	logger.Init()
+	logger.Init(cfg.LogLevel)
 	defer logger.Logger.Sync() // Сбрасываем буфер на случай использования асинхронного логирования
 
 	logger.Logger.Info("Starting PR Reviewer Service...")
 
 	// Подключение к базе данных
-	cfg := config.Load()
 	database, err := db.New(cfg)
 	if err != nil {
 		logger.Logger.Fatal("Failed to connect to database", zap.Error(err))
@@ -40,25 +52,143 @@ func main() {
 	defer database.Conn.Close()
 	logger.Logger.Info("Connected to PostgreSQL database successfully")
 
+	// Трейсинг
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing.Exporter, cfg.Tracing.ServiceName, cfg.Tracing.SampleRatio)
+	if err != nil {
+		logger.Logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
+	logger.Logger.Info("Tracing initialized", zap.String("exporter", cfg.Tracing.Exporter))
+
 	// Репозитории
 	teamRepo := repositories.NewTeamRepository(database.Conn)
 	userRepo := repositories.NewUserRepository(database.Conn)
 	prRepo := repositories.NewPRRepository(database.Conn)
+	slaRepo := repositories.NewSLARepository(database.Conn)
+	codeOwnersRepo := repositories.NewCodeOwnersRepository(database.Conn)
+	apiKeyRepo := repositories.NewAPIKeyRepository(database.Conn)
+	bulkRepo := repositories.NewBulkRepository(database.Conn)
+	scimRepo := repositories.NewScimRepository(database.Conn)
+	integrationRepo := repositories.NewIntegrationRepository(database.Conn)
+	notificationRepo := repositories.NewNotificationRepository(database.Conn)
+	statsRepo := repositories.NewStatsRepository(database.Conn)
 	logger.Logger.Info("Repositories initialized")
 
 	// Сервисы
+	templates, err := notify.LoadTemplates(cfg.Notify.TemplatesDir)
+	if err != nil {
+		logger.Logger.Fatal("Failed to load notification templates", zap.Error(err))
+	}
+	notificationService, err := services.NewNotificationService(notificationRepo, prRepo, templates, notify.NewSender(), cfg.Notify.DigestAt)
+	if err != nil {
+		logger.Logger.Fatal("Failed to initialize notifications", zap.Error(err))
+	}
+	notificationService.Start()
+	var emailDigestService *services.EmailDigestService
+	if cfg.SMTP.Host != "" {
+		mailer := notify.NewMailer(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.From)
+		emailDigestService, err = services.NewEmailDigestService(notificationRepo, templates, mailer, cfg.SMTP.DigestAt)
+		if err != nil {
+			logger.Logger.Fatal("Failed to initialize email digest", zap.Error(err))
+		}
+	}
 	teamService := services.NewTeamService(teamRepo)
 	userService := services.NewUserService(userRepo, prRepo)
-	prService := services.NewPRService(prRepo, userRepo, teamRepo)
+	prService := services.NewPRService(prRepo, userRepo, teamRepo, notificationService)
+	slaService := services.NewSLAService(slaRepo, prService, notificationService)
+	codeOwnersService := services.NewCodeOwnersService(codeOwnersRepo)
+	bulkService := services.NewBulkService(bulkRepo)
+	scimService := services.NewScimService(scimRepo, prService)
+	statsService := services.NewStatsService(statsRepo)
+	gitlabService := services.NewGitLabService(prService, integrationRepo, gitlab.NewClient(cfg.GitLab.BaseURL, cfg.GitLab.Token))
+	sched := scheduler.New()
+	healthService := services.NewHealthService(database, sched)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, cfg.Auth.AdminAPIKey)
+	if cfg.Auth.AdminAPIKey == "" {
+		logger.Logger.Warn("ADMIN_API_KEY is not set: only keys stored in the database are accepted")
+	}
 	logger.Logger.Info("Services initialized")
 
 	// Handlers и маршруты
+	metrics.Register(database.Conn, prRepo.OpenReviewLoad)
+
 	r := chi.NewRouter()
-	handlers.RegisterTeamRoutes(r, teamService)
-	handlers.RegisterUserRoutes(r, userService)
-	handlers.RegisterPRRoutes(r, prService)
+	r.Use(tracing.Middleware)
+	r.Use(middleware.RequestLogger)
+	r.Use(metrics.Middleware)
+	authenticators := []middleware.Authenticator{apiKeyService}
+	if cfg.Auth.JWKS != "" {
+		verifier, err := auth.NewJWTVerifier(auth.JWTOptions{
+			JWKS:      cfg.Auth.JWKS,
+			Issuer:    cfg.Auth.JWTIssuer,
+			Audience:  cfg.Auth.JWTAudience,
+			UserClaim: cfg.Auth.JWTUserClaim,
+			RoleClaim: cfg.Auth.JWTRoleClaim,
+		})
+		if err != nil {
+			logger.Logger.Fatal("Failed to initialize JWT verifier", zap.Error(err))
+		}
+		// JWT проверяется первым: строка не в формате JWT уходит к API-ключам
+		authenticators = []middleware.Authenticator{verifier, apiKeyService}
+		logger.Logger.Info("JWT authentication enabled", zap.String("jwks", cfg.Auth.JWKS))
+	}
+	r.Use(middleware.Authenticate(authenticators...))
+	graphQL, err := graphqlapi.NewServer(userRepo, teamRepo, prRepo, prService, teamService, statsService,
+		graphqlapi.Limits{MaxComplexity: cfg.GraphQL.MaxComplexity, MaxDepth: cfg.GraphQL.MaxDepth})
+	if err != nil {
+		logger.Logger.Fatal("Failed to initialize GraphQL", zap.Error(err))
+	}
+	// Все маршруты описаны в openapi.yml: сгенерированные типы, проверка запросов и ответов по спецификации
+	apiServer, err := httpapi.NewServer(httpapi.Services{
+		Teams:         teamService,
+		Users:         userService,
+		PRs:           prService,
+		Health:        healthService,
+		SLA:           slaService,
+		CodeOwners:    codeOwnersService,
+		APIKeys:       apiKeyService,
+		Bulk:          bulkService,
+		SCIM:          scimService,
+		Notifications: notificationService,
+		GraphQL:       graphQL,
+		GitLab:        gitlabService,
+		GitLabSecret:  cfg.GitLab.WebhookSecret,
+	}, cfg.OpenAPI.ResponseValidation)
+	if err != nil {
+		logger.Logger.Fatal("Failed to initialize OpenAPI server", zap.Error(err))
+	}
+	apiServer.Register(r)
+	if cfg.GitLab.WebhookSecret != "" {
+		logger.Logger.Info("GitLab integration enabled", zap.String("url", cfg.GitLab.BaseURL))
+	}
 	logger.Logger.Info("HTTP routes registered")
 
+	// Фоновые задачи
+	sched.Add(scheduler.Job{
+		Name:     "sla-check",
+		Interval: cfg.SLA.CheckInterval,
+		Run: func(ctx context.Context) error {
+			return slaService.CheckOverdue(ctx, time.Now())
+		},
+	})
+	sched.Add(scheduler.Job{
+		Name:     "review-digest",
+		Interval: 5 * time.Minute,
+		Run: func(ctx context.Context) error {
+			return notificationService.SendDigest(ctx, time.Now())
+		},
+	})
+	if emailDigestService != nil {
+		sched.Add(scheduler.Job{
+			Name:     "email-digest",
+			Interval: 5 * time.Minute,
+			Run: func(ctx context.Context) error {
+				return emailDigestService.SendDigest(ctx, time.Now())
+			},
+		})
+		logger.Logger.Info("Email digest enabled", zap.String("smtp_host", cfg.SMTP.Host), zap.String("at", cfg.SMTP.DigestAt))
+	}
+	sched.Start(context.Background())
+
 	// HTTP сервер с graceful shutdown
 	addr := ":" + cfg.Server.Port
 	server := &http.Server{
@@ -78,6 +208,23 @@ func main() {
 		serverErrors <- server.ListenAndServe()
 	}()
 
+	// gRPC API - те же сервисы и учётные данные на отдельном порту
+	var grpcServer *grpcapi.Server
+	if cfg.Server.GRPCPort != "" {
+		grpcAddr := ":" + cfg.Server.GRPCPort
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			logger.Logger.Fatal("Failed to listen for gRPC", zap.Error(err), zap.String("address", grpcAddr))
+		}
+		grpcServer = grpcapi.NewServer(authenticators, teamService, userService, prService)
+		go func() {
+			logger.Logger.Info("Starting gRPC server", zap.String("address", grpcAddr))
+			if err := grpcServer.Serve(lis); err != nil {
+				serverErrors <- err
+			}
+		}()
+	}
+
 	// Канал для сигналов ОС
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -92,6 +239,17 @@ func main() {
 		}
 	}
 
+	// Сначала перестаём быть ready и даём балансировщику время снять трафик,
+	// потом уже останавливаем сервер
+	healthService.SetDraining()
+	if grpcServer != nil {
+		grpcServer.SetDraining()
+	}
+	if cfg.Server.DrainDelay > 0 {
+		logger.Logger.Info("Draining before shutdown", zap.Duration("delay", cfg.Server.DrainDelay))
+		time.Sleep(cfg.Server.DrainDelay)
+	}
+
 	// Graceful shutdown с таймаутом
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -100,6 +258,14 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		logger.Logger.Error("Server shutdown error", zap.Error(err))
 	}
+	if grpcServer != nil {
+		grpcServer.Shutdown(ctx)
+	}
+	sched.Stop()
+	notificationService.Stop()
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Logger.Error("Failed to flush traces", zap.Error(err))
+	}
 
 	logger.Logger.Info("Server stopped successfully")
 }
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"pr-reviewer-service/config"
+	"pr-reviewer-service/internal/db"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/repositories"
+	"pr-reviewer-service/internal/services"
+	"strconv"
+	"strings"
+)
+
+// apiPR - PR в формате ответов API
+type apiPR struct {
+	ID                string   `json:"pull_request_id"`
+	Title             string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	Status            string   `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	CreatedAt         string   `json:"createdAt"`
+	MergedAt          string   `json:"mergedAt"`
+	Understaffed      bool     `json:"understaffed"`
+}
+
+func prRows(prs []apiPR) [][]string {
+	rows := make([][]string, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, []string{pr.ID, pr.Title, pr.AuthorID, pr.Status, strings.Join(pr.AssignedReviewers, ","), pr.CreatedAt, pr.MergedAt})
+	}
+	return rows
+}
+
+var prHeader = []string{"ID", "TITLE", "AUTHOR", "STATUS", "REVIEWERS", "CREATED", "MERGED"}
+
+// openDB - прямое подключение к БД по DB_* (как у сервиса). Логи репозиториев - только ошибки
+func openDB() (*db.DB, error) {
+	logger.Init("error")
+	return db.New(config.Load())
+}
+
+func (a *app) teams(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("teams: expected list, get, add or remove-member")
+	}
+	switch args[0] {
+	case "list":
+		database, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer database.Conn.Close()
+
+		teams, err := services.NewTeamService(repositories.NewTeamRepository(database.Conn)).ListTeams(context.Background())
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(teams))
+		for _, t := range teams {
+			rows = append(rows, []string{t.TeamName, strconv.Itoa(t.Members), strconv.Itoa(t.ActiveMembers)})
+		}
+		return a.print(teams, []string{"TEAM", "MEMBERS", "ACTIVE"}, rows)
+
+	case "get":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: teams get NAME")
+		}
+		var team openapi.Team
+		raw, err := a.call("GET", "/team/get", url.Values{"team_name": {args[1]}}, nil, &team)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(team.Members))
+		for _, m := range team.Members {
+			rows = append(rows, []string{m.UserId, m.Username, strconv.FormatBool(m.IsActive)})
+		}
+		return a.print(raw, []string{"USER", "USERNAME", "ACTIVE"}, rows)
+
+	case "add":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: teams add NAME ID:USERNAME[:inactive]...")
+		}
+		team := openapi.Team{TeamName: args[1], Members: []openapi.TeamMember{}}
+		for _, spec := range args[2:] {
+			parts := strings.Split(spec, ":")
+			if len(parts) < 2 || len(parts) > 3 || (len(parts) == 3 && parts[2] != "inactive") {
+				return fmt.Errorf("bad member %q: expected ID:USERNAME[:inactive]", spec)
+			}
+			id, err := parseID(parts[0], "u")
+			if err != nil {
+				return err
+			}
+			team.Members = append(team.Members, openapi.TeamMember{UserId: fmt.Sprintf("u%d", id), Username: parts[1], IsActive: len(parts) == 2})
+		}
+		raw, err := a.call("POST", "/team/add", nil, team, nil)
+		if err != nil {
+			return err
+		}
+		return a.print(raw, []string{"TEAM", "MEMBERS"}, [][]string{{team.TeamName, strconv.Itoa(len(team.Members))}})
+
+	case "remove-member":
+		if len(args) != 3 {
+			return fmt.Errorf("usage: teams remove-member NAME USER_ID")
+		}
+		userID, err := parseID(args[2], "u")
+		if err != nil {
+			return err
+		}
+		database, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer database.Conn.Close()
+
+		if err := services.NewTeamService(repositories.NewTeamRepository(database.Conn)).RemoveMember(context.Background(), args[1], userID); err != nil {
+			if err.Error() == "not found" {
+				return fmt.Errorf("user u%d is not a member of team %q", userID, args[1])
+			}
+			return err
+		}
+		result := map[string]interface{}{"team_name": args[1], "removed_user_id": fmt.Sprintf("u%d", userID)}
+		return a.print(result, []string{"TEAM", "REMOVED"}, [][]string{{args[1], fmt.Sprintf("u%d", userID)}})
+	}
+	return fmt.Errorf("teams: unknown subcommand %q", args[0])
+}
+
+func (a *app) users(args []string) error {
+	if len(args) != 2 || (args[0] != "activate" && args[0] != "deactivate") {
+		return fmt.Errorf("usage: users activate|deactivate USER_ID")
+	}
+	userID, err := parseID(args[1], "u")
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		User struct {
+			UserID   string `json:"user_id"`
+			Username string `json:"username"`
+			IsActive bool   `json:"is_active"`
+		} `json:"user"`
+	}
+	body := map[string]interface{}{"user_id": fmt.Sprintf("u%d", userID), "is_active": args[0] == "activate"}
+	raw, err := a.call("POST", "/users/setIsActive", nil, body, &resp)
+	if err != nil {
+		return err
+	}
+	return a.print(raw, []string{"USER", "USERNAME", "ACTIVE"},
+		[][]string{{resp.User.UserID, resp.User.Username, strconv.FormatBool(resp.User.IsActive)}})
+}
+
+func (a *app) prs(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("prs: expected list, get, create, merge or reassign")
+	}
+	switch args[0] {
+	case "list":
+		fs := flag.NewFlagSet("prs list", flag.ExitOnError)
+		query := url.Values{}
+		for _, name := range []string{"status", "team", "author", "reviewer", "q", "sort", "limit", "cursor", "created-from", "created-to", "merged-from", "merged-to"} {
+			fs.String(name, "", name)
+		}
+		fs.Parse(args[1:])
+		params := map[string]string{"team": "team_name", "author": "author_id", "reviewer": "reviewer_id",
+			"created-from": "created_from", "created-to": "created_to", "merged-from": "merged_from", "merged-to": "merged_to"}
+		fs.Visit(func(f *flag.Flag) {
+			name := f.Name
+			if p, ok := params[name]; ok {
+				name = p
+			}
+			query.Set(name, f.Value.String())
+		})
+
+		var resp struct {
+			PullRequests []apiPR `json:"pull_requests"`
+			NextCursor   string  `json:"next_cursor"`
+		}
+		raw, err := a.call("GET", "/pullRequest/list", query, nil, &resp)
+		if err != nil {
+			return err
+		}
+		if err := a.print(raw, prHeader, prRows(resp.PullRequests)); err != nil {
+			return err
+		}
+		if a.output == "table" && resp.NextCursor != "" {
+			fmt.Fprintf(os.Stderr, "next page: -cursor %s\n", resp.NextCursor)
+		}
+		return nil
+
+	case "get":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: prs get PR_ID")
+		}
+		var resp struct {
+			PR apiPR `json:"pr"`
+		}
+		raw, err := a.call("GET", "/pullRequest/get", url.Values{"pull_request_id": {args[1]}}, nil, &resp)
+		if err != nil {
+			return err
+		}
+		return a.print(raw, prHeader, prRows([]apiPR{resp.PR}))
+
+	case "create":
+		fs := flag.NewFlagSet("prs create", flag.ExitOnError)
+		title := fs.String("title", "", "PR title")
+		author := fs.String("author", "", "author user ID")
+		team := fs.String("team", "", "team ID")
+		var files multiFlag
+		fs.Var(&files, "file", "changed file path (repeatable)")
+		fs.Parse(args[1:])
+
+		authorID, err := parseID(*author, "u")
+		if err != nil {
+			return fmt.Errorf("-author: %w", err)
+		}
+		teamID, err := parseID(*team, "")
+		if err != nil {
+			return fmt.Errorf("-team: %w", err)
+		}
+		body := map[string]interface{}{"pull_request_name": *title, "author_id": fmt.Sprintf("u%d", authorID), "team_id": teamID, "changed_files": []string(files)}
+		var resp struct {
+			PR apiPR `json:"pr"`
+		}
+		raw, err := a.call("POST", "/pullRequest/create", nil, body, &resp)
+		if err != nil {
+			return err
+		}
+		return a.print(raw, prHeader, prRows([]apiPR{resp.PR}))
+
+	case "merge":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: prs merge PR_ID")
+		}
+		prID, err := parseID(args[1], "pr-")
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			PR apiPR `json:"pr"`
+		}
+		raw, err := a.call("POST", "/pullRequest/merge", nil, map[string]interface{}{"pull_request_id": fmt.Sprintf("pr-%d", prID)}, &resp)
+		if err != nil {
+			return err
+		}
+		return a.print(raw, prHeader, prRows([]apiPR{resp.PR}))
+
+	case "reassign":
+		if len(args) != 3 {
+			return fmt.Errorf("usage: prs reassign PR_ID OLD_USER_ID")
+		}
+		prID, err := parseID(args[1], "pr-")
+		if err != nil {
+			return err
+		}
+		oldUserID, err := parseID(args[2], "u")
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			PR         apiPR  `json:"pr"`
+			ReplacedBy string `json:"replaced_by"`
+		}
+		raw, err := a.call("POST", "/pullRequest/reassign", nil, map[string]interface{}{"pull_request_id": fmt.Sprintf("pr-%d", prID), "old_user_id": fmt.Sprintf("u%d", oldUserID)}, &resp)
+		if err != nil {
+			return err
+		}
+		return a.print(raw, append(prHeader, "REPLACED_BY"), [][]string{append(prRows([]apiPR{resp.PR})[0], resp.ReplacedBy)})
+	}
+	return fmt.Errorf("prs: unknown subcommand %q", args[0])
+}
+
+func (a *app) stats(args []string) error {
+	database, err := openDB()
+	if err != nil {
+		return err
+	}
+	defer database.Conn.Close()
+
+	stats, err := services.NewStatsService(repositories.NewStatsRepository(database.Conn)).GetStats(context.Background())
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{
+		{"teams", strconv.Itoa(stats.Teams)},
+		{"users", fmt.Sprintf("%d (%d active)", stats.Users, stats.ActiveUsers)},
+		{"open PRs", fmt.Sprintf("%d (%d understaffed)", stats.OpenPRs, stats.UnderstaffedPRs)},
+		{"merged PRs", strconv.Itoa(stats.MergedPRs)},
+		{"avg time to merge", fmt.Sprintf("%.1fh", stats.AvgMergeHours)},
+	}
+	for _, l := range stats.ReviewerLoad {
+		rows = append(rows, []string{fmt.Sprintf("load u%d %s", l.UserID, l.Username), strconv.Itoa(l.OpenReviews)})
+	}
+	return a.print(stats, []string{"METRIC", "VALUE"}, rows)
+}
+
+func (a *app) migrate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down [N]|version")
+	}
+	database, err := openDB()
+	if err != nil {
+		return err
+	}
+	defer database.Conn.Close()
+
+	switch args[0] {
+	case "up":
+		if err := database.Migrate(0); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("down: N must be a positive number")
+			}
+		}
+		if err := database.Migrate(-steps); err != nil {
+			return err
+		}
+	case "version":
+	default:
+		return fmt.Errorf("migrate: unknown subcommand %q", args[0])
+	}
+
+	version, dirty, err := database.MigrationVersion(context.Background())
+	if err != nil {
+		return err
+	}
+	expected, err := db.ExpectedVersion()
+	if err != nil {
+		return err
+	}
+	result := map[string]interface{}{"version": version, "dirty": dirty, "latest": expected}
+	return a.print(result, []string{"VERSION", "DIRTY", "LATEST"},
+		[][]string{{strconv.Itoa(int(version)), strconv.FormatBool(dirty), strconv.Itoa(int(expected))}})
+}
+
+// call - запрос к API; возвращает сырой JSON ответа (для -o json) и декодирует его в out
+func (a *app) call(method, path string, query url.Values, body, out interface{}) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := a.api.do(method, path, query, body, &raw); err != nil {
+		return nil, err
+	}
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return nil, err
+		}
+	}
+	return raw, nil
+}
+
+// parseID принимает "3" или "u3" / "pr-3"
+func parseID(value, prefix string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(value, prefix))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id %q", value)
+	}
+	return id, nil
+}
+
+// multiFlag - повторяемый строковый флаг
+type multiFlag []string
+
+func (m *multiFlag) String() string     { return strings.Join(*m, ",") }
+func (m *multiFlag) Set(v string) error { *m = append(*m, v); return nil }
@@ -0,0 +1,116 @@
+// prctl - административная утилита PR Reviewer Service.
+//
+// Команды работают через HTTP API (PRCTL_URL, PRCTL_API_KEY) или напрямую с БД
+// (DB_HOST, DB_PORT, ...) там, где в API нет подходящего эндпоинта. Настройки берутся
+// из окружения и файла в формате .env: -config, $PRCTL_CONFIG или ~/.config/prctl/config.env
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: prctl [-config FILE] [-o table|json] <command> [args]
+
+Commands:
+  teams list                                  команды и численность (БД)
+  teams get NAME                              команда с участниками
+  teams add NAME ID:USERNAME[:inactive]...    создать/обновить команду
+  teams remove-member NAME USER_ID            исключить пользователя из команды (БД)
+  users activate USER_ID
+  users deactivate USER_ID
+  prs list [-status S] [-team T] [-author ID] [-reviewer ID] [-q TEXT] [-sort F] [-limit N] [-cursor C]
+           [-created-from D] [-created-to D] [-merged-from D] [-merged-to D]
+  prs get PR_ID
+  prs create -title T -author ID -team ID [-file PATH]...
+  prs merge PR_ID
+  prs reassign PR_ID OLD_USER_ID
+  stats                                       сводка и нагрузка ревьюверов (БД)
+  migrate up|down [N]|version                 миграции схемы (БД)
+`
+
+type app struct {
+	output string
+	api    *apiClient
+}
+
+func main() {
+	global := flag.NewFlagSet("prctl", flag.ExitOnError)
+	global.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configPath := global.String("config", "", "config file (.env format)")
+	output := global.String("o", "table", "output format: table or json")
+	global.Parse(os.Args[1:])
+
+	if err := loadConfig(*configPath); err != nil {
+		fail(err)
+	}
+	if *output != "table" && *output != "json" {
+		fail(fmt.Errorf("unknown output format %q", *output))
+	}
+
+	args := global.Args()
+	if len(args) == 0 {
+		global.Usage()
+		os.Exit(2)
+	}
+
+	a := &app{
+		output: *output,
+		api:    newAPIClient(getEnv("PRCTL_URL", "http://localhost:8080"), os.Getenv("PRCTL_API_KEY")),
+	}
+
+	var err error
+	switch args[0] {
+	case "teams":
+		err = a.teams(args[1:])
+	case "users":
+		err = a.users(args[1:])
+	case "prs":
+		err = a.prs(args[1:])
+	case "stats":
+		err = a.stats(args[1:])
+	case "migrate":
+		err = a.migrate(args[1:])
+	case "help", "-h", "--help":
+		global.Usage()
+		return
+	default:
+		err = fmt.Errorf("unknown command %q", args[0])
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+// loadConfig подгружает файл настроек в окружение; уже заданные переменные окружения важнее
+func loadConfig(path string) error {
+	if path != "" {
+		return godotenv.Load(path)
+	}
+	if path = os.Getenv("PRCTL_CONFIG"); path != "" {
+		return godotenv.Load(path)
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		path = filepath.Join(dir, "prctl", "config.env")
+		if _, err := os.Stat(path); err == nil {
+			return godotenv.Load(path)
+		}
+	}
+	return nil
+}
+
+func getEnv(key, defaultVal string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return defaultVal
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "prctl:", err)
+	os.Exit(1)
+}
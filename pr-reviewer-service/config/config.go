@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -11,6 +12,14 @@ type Config struct {
 	DB       DBConfig
 	Server   ServerConfig
 	GitHub   GitHubConfig
+	GitLab   GitLabConfig
+	SLA      SLAConfig
+	Notify   NotifyConfig
+	SMTP     SMTPConfig
+	GraphQL  GraphQLConfig
+	OpenAPI  OpenAPIConfig
+	Auth     AuthConfig
+	Tracing  TracingConfig
 	LogLevel string
 }
 
@@ -25,17 +34,87 @@ type DBConfig struct {
 type ServerConfig struct {
 	Port string
 	Host string
+	// GRPCPort - порт gRPC API; пусто - gRPC выключен
+	GRPCPort string
+	// DrainDelay - пауза между SIGTERM (readiness уже 503) и остановкой сервера
+	DrainDelay time.Duration
 }
 
 type GitHubConfig struct {
 	Token string
 }
 
+type GitLabConfig struct {
+	// BaseURL - адрес инстанса (без /api/v4); в тестах - локальный фейк
+	BaseURL string
+	// Token - токен API для чтения MR и назначения ревьюверов
+	Token string
+	// WebhookSecret - X-Gitlab-Token вебхука; пусто - интеграция выключена
+	WebhookSecret string
+}
+
+type SLAConfig struct {
+	// Как часто планировщик ищет просроченные ревью
+	CheckInterval time.Duration
+}
+
+type NotifyConfig struct {
+	// TemplatesDir - каталог с <событие>.tmpl вместо встроенных текстов; пусто - встроенные
+	TemplatesDir string
+	// DigestAt - время ежедневного дайджеста открытых ревью (HH:MM по часовому поясу
+	// сервера); пусто - дайджест выключен
+	DigestAt string
+}
+
+type SMTPConfig struct {
+	// Host - SMTP-сервер; пусто - email-дайджест выключен
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// DigestAt - время ежедневного письма (HH:MM по часовому поясу сервера); пусто - без писем
+	DigestAt string
+}
+
+type GraphQLConfig struct {
+	// Предельная оценка сложности и вложенность запроса; 0 - без ограничения
+	MaxComplexity int
+	MaxDepth      int
+}
+
+type OpenAPIConfig struct {
+	// ResponseValidation - что делать с ответом, не соответствующим openapi.yml:
+	// off - не проверять, log - записать в лог, strict - вернуть 500
+	ResponseValidation string
+}
+
+type AuthConfig struct {
+	// Ключ администратора из окружения - чтобы создать первые API-ключи
+	AdminAPIKey string
+	// JWKS - файл или URL набора ключей; пусто - JWT не принимаются
+	JWKS         string
+	JWTIssuer    string
+	JWTAudience  string
+	JWTUserClaim string
+	JWTRoleClaim string
+}
+
+type TracingConfig struct {
+	// Exporter - none, otlp (адрес - OTEL_EXPORTER_OTLP_ENDPOINT) или stdout
+	Exporter    string
+	ServiceName string
+	SampleRatio float64
+}
+
 func Load() *Config {
 	// Загружаем .env файл (опционально, если существует)
 	_ = godotenv.Load()
 
 	dbPort, _ := strconv.Atoi(getEnv("DB_PORT", "5432"))
+	smtpPort, _ := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	graphQLComplexity, _ := strconv.Atoi(getEnv("GRAPHQL_MAX_COMPLEXITY", "5000"))
+	graphQLDepth, _ := strconv.Atoi(getEnv("GRAPHQL_MAX_DEPTH", "8"))
 
 	return &Config{
 		DB: DBConfig{
@@ -46,12 +125,54 @@ func Load() *Config {
 			Name:     getEnv("DB_NAME", "pr_reviewer"),
 		},
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
-			Host: getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:       getEnv("SERVER_PORT", "8080"),
+			GRPCPort:   getEnv("GRPC_PORT", "9090"),
+			Host:       getEnv("SERVER_HOST", "0.0.0.0"),
+			DrainDelay: getDuration("SHUTDOWN_DRAIN_DELAY", 5*time.Second),
 		},
 		GitHub: GitHubConfig{
 			Token: getEnv("GITHUB_TOKEN", ""),
 		},
+		GitLab: GitLabConfig{
+			BaseURL:       getEnv("GITLAB_URL", "https://gitlab.com"),
+			Token:         getEnv("GITLAB_TOKEN", ""),
+			WebhookSecret: getEnv("GITLAB_WEBHOOK_SECRET", ""),
+		},
+		SLA: SLAConfig{
+			CheckInterval: getDuration("SLA_CHECK_INTERVAL", 5*time.Minute),
+		},
+		Notify: NotifyConfig{
+			TemplatesDir: getEnv("NOTIFY_TEMPLATES_DIR", ""),
+			DigestAt:     getEnv("NOTIFY_DIGEST_AT", "09:00"),
+		},
+		SMTP: SMTPConfig{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     smtpPort,
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "pr-reviewer@localhost"),
+			DigestAt: getEnv("EMAIL_DIGEST_AT", "09:00"),
+		},
+		GraphQL: GraphQLConfig{
+			MaxComplexity: graphQLComplexity,
+			MaxDepth:      graphQLDepth,
+		},
+		OpenAPI: OpenAPIConfig{
+			ResponseValidation: getEnv("OPENAPI_RESPONSE_VALIDATION", "log"),
+		},
+		Auth: AuthConfig{
+			AdminAPIKey:  getEnv("ADMIN_API_KEY", ""),
+			JWKS:         getEnv("JWT_JWKS", ""),
+			JWTIssuer:    getEnv("JWT_ISSUER", ""),
+			JWTAudience:  getEnv("JWT_AUDIENCE", ""),
+			JWTUserClaim: getEnv("JWT_USER_CLAIM", "sub"),
+			JWTRoleClaim: getEnv("JWT_ROLE_CLAIM", "role"),
+		},
+		Tracing: TracingConfig{
+			Exporter:    getEnv("TRACE_EXPORTER", "none"),
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "pr-reviewer-service"),
+			SampleRatio: getFloat("TRACE_SAMPLE_RATIO", 1),
+		},
 		LogLevel: getEnv("LOG_LEVEL", "info"),
 	}
 }
@@ -62,3 +183,23 @@ func getEnv(key, defaultVal string) string {
 	}
 	return defaultVal
 }
+
+// getDuration - только положительные значения: 0 и отрицательные интервалы
+// (time.NewTicker с ними паникует) заменяются значением по умолчанию
+func getDuration(key string, defaultVal time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultVal
+}
+
+func getFloat(key string, defaultVal float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return defaultVal
+}
@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// jwksRefreshInterval - не чаще этого перечитываем JWKS при незнакомом kid (ротация ключей у IdP).
+// После неудачных попыток интервал удваивается до jwksMaxBackoff
+const (
+	jwksRefreshInterval = time.Minute
+	jwksMaxBackoff      = 15 * time.Minute
+)
+
+type JWTOptions struct {
+	// JWKS - путь к файлу или http(s)-адрес набора ключей
+	JWKS      string
+	Issuer    string // пусто - не проверяется
+	Audience  string // пусто - не проверяется
+	UserClaim string // claim с ID пользователя ("u3" или 3), по умолчанию sub
+	RoleClaim string // claim с ролью, по умолчанию role; без него - reviewer
+}
+
+// JWTVerifier проверяет bearer-токены, подписанные ключами из JWKS
+type JWTVerifier struct {
+	opts JWTOptions
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	checkedAt time.Time // последняя попытка перечитать набор, удачная или нет
+	failures  int       // неудачных попыток подряд
+
+	// reload - одно перечитывание за раз: остальные запросы с незнакомым kid ждут его результата
+	reload sync.Mutex
+}
+
+func NewJWTVerifier(opts JWTOptions) (*JWTVerifier, error) {
+	if opts.UserClaim == "" {
+		opts.UserClaim = "sub"
+	}
+	if opts.RoleClaim == "" {
+		opts.RoleClaim = "role"
+	}
+
+	keys, err := loadJWKS(opts.JWKS)
+	if err != nil {
+		return nil, fmt.Errorf("load JWKS from %s: %w", opts.JWKS, err)
+	}
+	return &JWTVerifier{opts: opts, keys: keys, checkedAt: time.Now()}, nil
+}
+
+// Authenticate проверяет подпись, срок действия, issuer/audience и возвращает пользователя
+// из claims. Строку, не похожую на JWT, не трогает ("not found"), чтобы её проверили как API-ключ
+func (v *JWTVerifier) Authenticate(ctx context.Context, raw string) (*models.Principal, error) {
+	if strings.Count(raw, ".") != 2 {
+		return nil, fmt.Errorf("not found")
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if v.opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(v.opts.Issuer))
+	}
+	if v.opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(v.opts.Audience))
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		return v.keyFunc(ctx, token)
+	}, parserOpts...); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	userID, err := claimUserID(claims[v.opts.UserClaim])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: claim %s: %w", v.opts.UserClaim, err)
+	}
+
+	role := models.RoleReviewer
+	if value, ok := claims[v.opts.RoleClaim]; ok {
+		s, _ := value.(string)
+		switch s {
+		case models.RoleAdmin, models.RoleBot, models.RoleReader, models.RoleReviewer:
+			role = s
+		default:
+			return nil, fmt.Errorf("invalid token: unknown role %q", s)
+		}
+	}
+
+	return &models.Principal{Name: fmt.Sprintf("u%d", userID), Role: role, UserID: userID}, nil
+}
+
+func (v *JWTVerifier) keyFunc(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok, due := v.find(kid)
+	if ok {
+		return key, nil
+	}
+	if !due {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	// Незнакомый kid - возможно, IdP повернул ключи. Пока ждали reload,
+	// набор мог перечитать другой запрос - тогда повторно не идём
+	v.reload.Lock()
+	defer v.reload.Unlock()
+	key, ok, due = v.find(kid)
+	if ok {
+		return key, nil
+	}
+	if !due {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	keys, err := loadJWKS(v.opts.JWKS)
+	v.mu.Lock()
+	v.checkedAt = time.Now()
+	if err != nil {
+		v.failures++
+	} else {
+		v.keys, v.failures = keys, 0
+	}
+	failures := v.failures
+	key, ok = v.lookup(kid)
+	v.mu.Unlock()
+
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to reload JWKS", zap.Error(err), zap.String("jwks", v.opts.JWKS),
+			zap.Int("failures", failures), zap.Duration("retry_after", reloadBackoff(failures)))
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	logger.FromContext(ctx).Info("Reloaded JWKS", zap.Int("keys", len(keys)))
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+// find - ключ по kid и пора ли перечитать набор, если ключа нет
+func (v *JWTVerifier) find(kid string) (crypto.PublicKey, bool, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok := v.lookup(kid)
+	return key, ok, time.Since(v.checkedAt) >= reloadBackoff(v.failures)
+}
+
+// reloadBackoff - пауза перед следующим перечитыванием после failures неудач подряд
+func reloadBackoff(failures int) time.Duration {
+	d := jwksRefreshInterval
+	for i := 0; i < failures && d < jwksMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, jwksMaxBackoff)
+}
+
+// lookup - ключ по kid; токен без kid допустим, если ключ в наборе один
+func (v *JWTVerifier) lookup(kid string) (crypto.PublicKey, bool) {
+	if key, ok := v.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// claimUserID принимает "u3", "3" или число
+func claimUserID(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case string:
+		id, err := strconv.Atoi(strings.TrimPrefix(v, "u"))
+		if err != nil || id <= 0 {
+			return 0, fmt.Errorf("bad user id %q", v)
+		}
+		return id, nil
+	case float64:
+		if v <= 0 || v != float64(int(v)) {
+			return 0, fmt.Errorf("bad user id %v", v)
+		}
+		return int(v), nil
+	case nil:
+		return 0, fmt.Errorf("missing")
+	default:
+		return 0, fmt.Errorf("bad user id %v", v)
+	}
+}
@@ -0,0 +1,343 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "pr-reviewer"
+)
+
+// jwksServer отдаёт набор открытых ключей и считает запросы к нему
+type jwksServer struct {
+	*httptest.Server
+	fetches atomic.Int32
+
+	mu    sync.Mutex
+	keys  map[string]crypto.PublicKey
+	fail  bool
+	delay time.Duration
+}
+
+func newJWKSServer(t *testing.T, keys map[string]crypto.PublicKey) *jwksServer {
+	t.Helper()
+	s := &jwksServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		keys, fail, delay := s.keys, s.fail, s.delay
+		s.mu.Unlock()
+		time.Sleep(delay)
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(jwkSetOf(t, keys))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) set(keys map[string]crypto.PublicKey, fail bool) {
+	s.mu.Lock()
+	s.keys, s.fail = keys, fail
+	s.mu.Unlock()
+}
+
+func jwkSetOf(t *testing.T, keys map[string]crypto.PublicKey) jwkSet {
+	enc := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	var set jwkSet
+	for kid, key := range keys {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, jwk{Kid: kid, Kty: "RSA", Use: "sig",
+				N: enc(k.N.Bytes()), E: enc(big.NewInt(int64(k.E)).Bytes())})
+		case *ecdsa.PublicKey:
+			size := (k.Curve.Params().BitSize + 7) / 8
+			set.Keys = append(set.Keys, jwk{Kid: kid, Kty: "EC", Crv: k.Curve.Params().Name,
+				X: enc(k.X.FillBytes(make([]byte, size))), Y: enc(k.Y.FillBytes(make([]byte, size)))})
+		default:
+			t.Fatalf("unsupported key %T", key)
+		}
+	}
+	return set
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key crypto.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return raw
+}
+
+// claims - корректный набор claims, поверх которого накладывается override (nil - удалить claim)
+func claims(override jwt.MapClaims) jwt.MapClaims {
+	c := jwt.MapClaims{
+		"sub": "u3",
+		"iss": testIssuer,
+		"aud": testAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range override {
+		if v == nil {
+			delete(c, k)
+			continue
+		}
+		c[k] = v
+	}
+	return c
+}
+
+type testKeys struct {
+	rsa   *rsa.PrivateKey
+	ec    *ecdsa.PrivateKey
+	other *rsa.PrivateKey
+}
+
+func generateKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKeys{rsa: rsaKey, ec: ecKey, other: other}
+}
+
+func newTestVerifier(t *testing.T, keys testKeys) (*JWTVerifier, *jwksServer) {
+	t.Helper()
+	srv := newJWKSServer(t, map[string]crypto.PublicKey{"rsa": &keys.rsa.PublicKey, "ec": &keys.ec.PublicKey})
+	v, err := NewJWTVerifier(JWTOptions{JWKS: srv.URL, Issuer: testIssuer, Audience: testAudience})
+	if err != nil {
+		t.Fatalf("NewJWTVerifier: %v", err)
+	}
+	return v, srv
+}
+
+// expireReload - интервал до следующего перечитывания JWKS истёк
+func (v *JWTVerifier) expireReload() {
+	v.mu.Lock()
+	v.checkedAt = time.Now().Add(-2 * jwksMaxBackoff)
+	v.mu.Unlock()
+}
+
+func TestJWTVerifierAuthenticate(t *testing.T) {
+	keys := generateKeys(t)
+	v, _ := newTestVerifier(t, keys)
+
+	tests := []struct {
+		name  string
+		token string
+		want  *models.Principal
+		err   string
+	}{
+		{name: "RSA", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(nil)),
+			want: &models.Principal{Name: "u3", Role: models.RoleReviewer, UserID: 3}},
+		{name: "EC with numeric sub", token: sign(t, jwt.SigningMethodES256, keys.ec, "ec", claims(jwt.MapClaims{"sub": 7})),
+			want: &models.Principal{Name: "u7", Role: models.RoleReviewer, UserID: 7}},
+		{name: "role admin", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"role": "admin"})),
+			want: &models.Principal{Name: "u3", Role: models.RoleAdmin, UserID: 3}},
+		{name: "role bot", token: sign(t, jwt.SigningMethodES256, keys.ec, "ec", claims(jwt.MapClaims{"role": "bot"})),
+			want: &models.Principal{Name: "u3", Role: models.RoleBot, UserID: 3}},
+		{name: "role reader", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"role": "reader"})),
+			want: &models.Principal{Name: "u3", Role: models.RoleReader, UserID: 3}},
+		{name: "unknown role", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"role": "root"})),
+			err: "unknown role"},
+		{name: "expired", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})),
+			err: "expired"},
+		{name: "without exp", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"exp": nil})),
+			err: "exp"},
+		{name: "wrong issuer", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"iss": "https://evil.example.com"})),
+			err: "issuer"},
+		{name: "wrong audience", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"aud": "someone-else"})),
+			err: "audience"},
+		{name: "bad user id", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"sub": "alice"})),
+			err: "claim sub"},
+		{name: "unknown kid", token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rotated", claims(nil)),
+			err: "unknown key id"},
+		{name: "signed by another key", token: sign(t, jwt.SigningMethodRS256, keys.other, "rsa", claims(nil)),
+			err: "verification error"},
+		{name: "HMAC", token: sign(t, jwt.SigningMethodHS256, []byte("secret"), "rsa", claims(nil)),
+			err: "signing method"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Authenticate(context.Background(), tt.token)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want containing %q", err, tt.err)
+				}
+				if err.Error() == "not found" {
+					t.Fatalf("JWT must not fall through to other authenticators")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("principal = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+type apiKeyAuth map[string]*models.Principal
+
+func (a apiKeyAuth) Authenticate(_ context.Context, raw string) (*models.Principal, error) {
+	if p, ok := a[raw]; ok {
+		return p, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestJWTVerifierFallsThroughToAPIKeys(t *testing.T) {
+	v, srv := newTestVerifier(t, generateKeys(t))
+	bot := &models.Principal{Name: "ci", Role: models.RoleBot, KeyID: 1}
+	auths := []middleware.Authenticator{v, apiKeyAuth{"prs_0123abcd": bot}}
+
+	if _, err := v.Authenticate(context.Background(), "prs_0123abcd"); err == nil || err.Error() != "not found" {
+		t.Fatalf("Authenticate(API key) err = %v, want not found", err)
+	}
+	got, err := middleware.Resolve(context.Background(), auths, "prs_0123abcd")
+	if err != nil || got != bot {
+		t.Fatalf("Resolve = %+v, %v, want API key owner", got, err)
+	}
+	if _, err := middleware.Resolve(context.Background(), auths, "prs_unknown"); err == nil {
+		t.Fatal("Resolve(unknown key) succeeded")
+	}
+	if n := srv.fetches.Load(); n != 1 {
+		t.Errorf("JWKS fetches = %d, want 1 (only at start)", n)
+	}
+}
+
+func TestJWTVerifierReloadsRotatedKeys(t *testing.T) {
+	keys := generateKeys(t)
+	v, srv := newTestVerifier(t, keys)
+	srv.set(map[string]crypto.PublicKey{"rotated": &keys.other.PublicKey}, false)
+	token := sign(t, jwt.SigningMethodRS256, keys.other, "rotated", claims(nil))
+
+	// Набор только что загружен - незнакомый kid не заставляет идти к IdP
+	if _, err := v.Authenticate(context.Background(), token); err == nil {
+		t.Fatal("token with unknown kid accepted before reload")
+	}
+	if n := srv.fetches.Load(); n != 1 {
+		t.Fatalf("JWKS fetches = %d, want 1", n)
+	}
+
+	v.expireReload()
+	if _, err := v.Authenticate(context.Background(), token); err != nil {
+		t.Fatalf("Authenticate after rotation: %v", err)
+	}
+	if n := srv.fetches.Load(); n != 2 {
+		t.Errorf("JWKS fetches = %d, want 2", n)
+	}
+}
+
+func TestJWTVerifierReloadIsSingleFlight(t *testing.T) {
+	keys := generateKeys(t)
+	v, srv := newTestVerifier(t, keys)
+	srv.mu.Lock()
+	srv.delay = 50 * time.Millisecond
+	srv.mu.Unlock()
+	token := sign(t, jwt.SigningMethodRS256, keys.other, "unknown", claims(nil))
+	v.expireReload()
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v.Authenticate(context.Background(), token)
+		}()
+	}
+	wg.Wait()
+	if n := srv.fetches.Load(); n != 2 {
+		t.Errorf("JWKS fetches = %d, want 2 (start + one reload)", n)
+	}
+}
+
+func TestJWTVerifierBacksOffAfterFailedReload(t *testing.T) {
+	keys := generateKeys(t)
+	v, srv := newTestVerifier(t, keys)
+	srv.set(nil, true)
+	token := sign(t, jwt.SigningMethodRS256, keys.other, "unknown", claims(nil))
+	valid := sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(nil))
+
+	v.expireReload()
+	for range 5 {
+		if _, err := v.Authenticate(context.Background(), token); err == nil {
+			t.Fatal("token with unknown kid accepted")
+		}
+	}
+	if n := srv.fetches.Load(); n != 2 {
+		t.Fatalf("JWKS fetches = %d, want 2: failed reload must not be retried on every token", n)
+	}
+	// Уже загруженные ключи продолжают работать
+	if _, err := v.Authenticate(context.Background(), valid); err != nil {
+		t.Fatalf("Authenticate with known key: %v", err)
+	}
+
+	// После неудачи пауза вдвое больше обычной
+	v.mu.Lock()
+	v.checkedAt = time.Now().Add(-jwksRefreshInterval - time.Second)
+	v.mu.Unlock()
+	v.Authenticate(context.Background(), token)
+	if n := srv.fetches.Load(); n != 2 {
+		t.Fatalf("JWKS fetches = %d, want 2 during backoff", n)
+	}
+
+	srv.set(map[string]crypto.PublicKey{"unknown": &keys.other.PublicKey}, false)
+	v.expireReload()
+	if _, err := v.Authenticate(context.Background(), token); err != nil {
+		t.Fatalf("Authenticate after IdP recovered: %v", err)
+	}
+	if v.failures != 0 {
+		t.Errorf("failures = %d after successful reload, want 0", v.failures)
+	}
+}
+
+func TestReloadBackoff(t *testing.T) {
+	for failures, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, jwksMaxBackoff, jwksMaxBackoff} {
+		if got := reloadBackoff(failures); got != want {
+			t.Errorf("reloadBackoff(%d) = %v, want %v", failures, got, want)
+		}
+	}
+	if got := reloadBackoff(100); got != jwksMaxBackoff {
+		t.Errorf("reloadBackoff(100) = %v, want %v", got, jwksMaxBackoff)
+	}
+}
@@ -0,0 +1,103 @@
+package codeowners
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Compile превращает шаблон в стиле CODEOWNERS в регулярное выражение:
+//   - "/" в начале или в середине привязывает шаблон к корню репозитория, иначе он
+//     совпадает на любой глубине (например, "*.sql" или "migrations/");
+//   - "*" и "?" не пересекают "/", "**" - пересекает;
+//   - "/" в конце совпадает только с каталогом и всем его содержимым;
+//   - шаблон без масок в последнем сегменте совпадает и с файлом, и с каталогом целиком;
+//   - пробелы внутри шаблона - часть имени (в файле CODEOWNERS они экранируются "\ ").
+func Compile(pattern string) (*regexp.Regexp, error) {
+	p := strings.TrimSpace(pattern)
+	if p == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	anchored := strings.HasPrefix(p, "/") || strings.Contains(strings.TrimPrefix(p, "/"), "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		// "/" - весь репозиторий
+		return regexp.Compile("^.*$")
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "/**") && i+3 == len(p):
+			b.WriteString("(?:/.*)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+
+	last := p[strings.LastIndex(p, "/")+1:]
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.ContainsAny(last, "*?"):
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String())
+}
+
+// Match - совпадает ли путь (относительно корня репозитория) с шаблоном
+func Match(pattern, path string) bool {
+	re, err := Compile(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// Rule - строка правил владения: шаблон и его владельцы
+type Rule[T any] struct {
+	Pattern string
+	Owners  T
+}
+
+// Owners возвращает владельцев каждого пути. Как в CODEOWNERS, для пути действует
+// последнее совпавшее правило; пути без совпадений в результат не попадают
+func Owners[T any](rules []Rule[T], paths []string) map[string]T {
+	compiled := make([]*regexp.Regexp, len(rules))
+	for i, rule := range rules {
+		compiled[i], _ = Compile(rule.Pattern)
+	}
+
+	result := make(map[string]T)
+	for _, path := range paths {
+		path = strings.TrimPrefix(path, "/")
+		for i := len(rules) - 1; i >= 0; i-- {
+			if compiled[i] != nil && compiled[i].MatchString(path) {
+				result[path] = rules[i].Owners
+				break
+			}
+		}
+	}
+	return result
+}
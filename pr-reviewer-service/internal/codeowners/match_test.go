@@ -0,0 +1,129 @@
+package codeowners
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		name    string
+		pattern string
+		match   []string
+		noMatch []string
+	}{
+		{"star in name", "*.sql",
+			[]string{"schema.sql", "db/migrations/0001_init.sql"},
+			[]string{"schema.sql.bak", "sql", "db/sql/readme.md"}},
+		{"star does not cross slash", "/docs/*.md",
+			[]string{"docs/readme.md"},
+			[]string{"docs/api/readme.md", "src/docs/readme.md"}},
+		{"question mark", "/v?/api.go",
+			[]string{"v1/api.go", "v2/api.go"},
+			[]string{"v10/api.go", "v/api.go"}},
+		{"double star in middle", "/src/**/handler.go",
+			[]string{"src/handler.go", "src/http/handler.go", "src/a/b/c/handler.go"},
+			[]string{"handler.go", "lib/src/http/handler.go"}},
+		{"leading double star", "**/testdata",
+			[]string{"testdata", "pkg/testdata/in.json", "a/b/testdata"},
+			[]string{"testdata2/in.json"}},
+		{"trailing double star", "/internal/**",
+			[]string{"internal/a.go", "internal/db/migrations/0001.sql"},
+			[]string{"internals/a.go", "cmd/internal/a.go"}},
+		{"leading slash anchors to root", "/build",
+			[]string{"build", "build/out.bin"},
+			[]string{"web/build", "web/build/out.bin", "builder"}},
+		{"slash in middle anchors to root", "internal/db",
+			[]string{"internal/db/pool.go"},
+			[]string{"pkg/internal/db/pool.go"}},
+		{"unanchored name at any depth", "Makefile",
+			[]string{"Makefile", "tools/Makefile"},
+			[]string{"Makefile.inc", "tools/GNUmakefile"}},
+		{"directory pattern", "migrations/",
+			[]string{"migrations/0001.sql", "internal/db/migrations/0001.sql", "migrations/a/b.sql"},
+			[]string{"migrations", "migrations.go", "internal/db/migrations"}},
+		{"anchored directory pattern", "/docs/",
+			[]string{"docs/readme.md", "docs/api/openapi.yml"},
+			[]string{"docs", "web/docs/readme.md"}},
+		{"whole repository", "/",
+			[]string{"a.go", "deep/dir/file.txt"},
+			nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			re, err := Compile(c.pattern)
+			if err != nil {
+				t.Fatalf("Compile(%q): %v", c.pattern, err)
+			}
+			for _, path := range c.match {
+				if !re.MatchString(path) {
+					t.Errorf("%q does not match %q (%s)", c.pattern, path, re)
+				}
+			}
+			for _, path := range c.noMatch {
+				if re.MatchString(path) {
+					t.Errorf("%q matches %q (%s)", c.pattern, path, re)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileInvalid(t *testing.T) {
+	for _, pattern := range []string{"", "   "} {
+		if _, err := Compile(pattern); err == nil {
+			t.Errorf("Compile(%q): want error", pattern)
+		}
+	}
+}
+
+func TestMatchLeadingSlashInPath(t *testing.T) {
+	if !Match("/cmd/", "/cmd/app/main.go") {
+		t.Error(`Match("/cmd/", "/cmd/app/main.go") = false`)
+	}
+	if Match("", "main.go") {
+		t.Error("empty pattern must not match")
+	}
+}
+
+func TestOwners(t *testing.T) {
+	rules := []Rule[string]{
+		{Pattern: "*", Owners: "default"},
+		{Pattern: "*.sql", Owners: "dba"},
+		{Pattern: "/internal/", Owners: "backend"},
+		{Pattern: "/internal/db/migrations/", Owners: "dba"},
+		{Pattern: "/docs/**/*.md", Owners: "writers"},
+		{Pattern: "", Owners: "never"}, // некорректное правило пропускается
+	}
+	cases := []struct {
+		name  string
+		paths []string
+		want  map[string]string
+	}{
+		{"fallback rule", []string{"README.md"}, map[string]string{"README.md": "default"}},
+		{"later rule wins", []string{"internal/http/server.go"}, map[string]string{"internal/http/server.go": "backend"}},
+		// *.sql раньше /internal/ - побеждает /internal/, а миграции снова забирает dba
+		{"last match wins over earlier one", []string{"internal/repo/queries.sql", "internal/db/migrations/0001.sql"},
+			map[string]string{"internal/repo/queries.sql": "backend", "internal/db/migrations/0001.sql": "dba"}},
+		{"double star rule", []string{"docs/api/v1/index.md", "docs/index.txt"},
+			map[string]string{"docs/api/v1/index.md": "writers", "docs/index.txt": "default"}},
+		{"leading slash in path", []string{"/internal/app.go"}, map[string]string{"internal/app.go": "backend"}},
+		{"no paths", nil, map[string]string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Owners(rules, c.paths); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Owners(%v) = %v, want %v", c.paths, got, c.want)
+			}
+		})
+	}
+}
+
+func TestOwnersWithoutMatch(t *testing.T) {
+	rules := []Rule[[]int]{{Pattern: "/web/", Owners: []int{1, 2}}}
+	got := Owners(rules, []string{"web/app.ts", "api/main.go"})
+	want := map[string][]int{"web/app.ts": {1, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Owners = %v, want %v (paths without a matching rule are omitted)", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// Entry - строка файла CODEOWNERS: шаблон и владельцы в исходном виде
+// (@user, @org/team или email)
+type Entry struct {
+	Line    int
+	Pattern string
+	Owners  []string
+}
+
+// Problem - строка, которую не удалось разобрать
+type Problem struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// Parse разбирает файл CODEOWNERS. Пустые строки, комментарии (#) и заголовки
+// секций GitLab ([Section]) пропускаются; "\#" в начале шаблона экранирует решётку,
+// "\ " - пробел в пути.
+// Некорректные строки не прерывают разбор, а попадают в problems
+func Parse(content string) ([]Entry, []Problem) {
+	var entries []Entry
+	var problems []Problem
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") || strings.HasPrefix(text, "^[") {
+			continue
+		}
+
+		fields := splitFields(stripComment(text))
+		if len(fields) == 0 {
+			continue
+		}
+
+		entry := Entry{Line: line, Pattern: strings.ReplaceAll(fields[0], `\#`, "#")}
+		if _, err := Compile(entry.Pattern); err != nil {
+			problems = append(problems, Problem{Line: line, Message: err.Error()})
+			continue
+		}
+
+		valid := true
+		for _, owner := range fields[1:] {
+			if !isHandle(owner) {
+				problems = append(problems, Problem{Line: line, Message: fmt.Sprintf("invalid owner %q", owner)})
+				valid = false
+				break
+			}
+			entry.Owners = append(entry.Owners, owner)
+		}
+		if valid {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		problems = append(problems, Problem{Line: line + 1, Message: err.Error()})
+	}
+	return entries, problems
+}
+
+// NormalizeHandle приводит владельца к ключу таблицы соответствий: без "@", в нижнем регистре
+func NormalizeHandle(owner string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(owner), "@"))
+}
+
+// stripComment отрезает комментарий в конце строки (" #..."), не трогая экранированный "\#"
+func stripComment(text string) string {
+	for i := 1; i < len(text); i++ {
+		if text[i] == '#' && (text[i-1] == ' ' || text[i-1] == '\t') {
+			return text[:i]
+		}
+	}
+	return text
+}
+
+// splitFields делит строку по пробелам, кроме экранированных ("\ "); экранирование снимается
+func splitFields(text string) []string {
+	var fields []string
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text) && text[i+1] == ' ':
+			b.WriteByte(' ')
+			i++
+		case text[i] == ' ' || text[i] == '\t':
+			if b.Len() > 0 {
+				fields = append(fields, b.String())
+				b.Reset()
+			}
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	if b.Len() > 0 {
+		fields = append(fields, b.String())
+	}
+	return fields
+}
+
+func isHandle(owner string) bool {
+	if strings.HasPrefix(owner, "@") {
+		name := owner[1:]
+		if name == "" || strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") {
+			return false
+		}
+		return true
+	}
+	// email
+	at := strings.Index(owner, "@")
+	return at > 0 && at < len(owner)-1 && !strings.Contains(owner[at+1:], "@")
+}
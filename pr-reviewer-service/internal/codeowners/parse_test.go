@@ -0,0 +1,101 @@
+package codeowners
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		entries  []Entry
+		problems []Problem
+	}{
+		{"comments and blank lines",
+			"# Владельцы\n\n   # отступ\n*.go @alice # backend\n",
+			[]Entry{{Line: 4, Pattern: "*.go", Owners: []string{"@alice"}}},
+			nil},
+		{"escaped hash",
+			`\#notes.md @bob`,
+			[]Entry{{Line: 1, Pattern: "#notes.md", Owners: []string{"@bob"}}},
+			nil},
+		{"GitLab sections",
+			"[Backend]\n*.go @alice\n^[Optional][2] @bob\n/docs/ docs@example.com\n",
+			[]Entry{
+				{Line: 2, Pattern: "*.go", Owners: []string{"@alice"}},
+				{Line: 4, Pattern: "/docs/", Owners: []string{"docs@example.com"}},
+			},
+			nil},
+		{"escaped spaces",
+			`/docs/My\ Guide.md @alice @acme/writers` + "\n" + `/a\ b/\ c/ @bob`,
+			[]Entry{
+				{Line: 1, Pattern: "/docs/My Guide.md", Owners: []string{"@alice", "@acme/writers"}},
+				{Line: 2, Pattern: "/a b/ c/", Owners: []string{"@bob"}},
+			},
+			nil},
+		{"rule without owners",
+			"/vendor/\n",
+			[]Entry{{Line: 1, Pattern: "/vendor/"}},
+			nil},
+		// Parse только проверяет синтаксис: есть ли handle в таблице соответствий,
+		// решает импорт (unknown_handles)
+		{"unknown and invalid handles",
+			"*.go @nobody @ghost/team\n*.sql @ bob\n*.md @acme/\n*.txt not-a-handle\n*.yml a@b@c\n",
+			[]Entry{{Line: 1, Pattern: "*.go", Owners: []string{"@nobody", "@ghost/team"}}},
+			[]Problem{
+				{Line: 2, Message: `invalid owner "@"`},
+				{Line: 3, Message: `invalid owner "@acme/"`},
+				{Line: 4, Message: `invalid owner "not-a-handle"`},
+				{Line: 5, Message: `invalid owner "a@b@c"`},
+			}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			entries, problems := Parse(c.content)
+			if !reflect.DeepEqual(entries, c.entries) {
+				t.Errorf("entries = %+v, want %+v", entries, c.entries)
+			}
+			if !reflect.DeepEqual(problems, c.problems) {
+				t.Errorf("problems = %+v, want %+v", problems, c.problems)
+			}
+		})
+	}
+}
+
+func TestParseEscapedSpaceMatches(t *testing.T) {
+	entries, problems := Parse(`/docs/My\ Guide.md @alice`)
+	if len(problems) != 0 || len(entries) != 1 {
+		t.Fatalf("entries = %+v, problems = %+v", entries, problems)
+	}
+	if !Match(entries[0].Pattern, "docs/My Guide.md") {
+		t.Errorf("%q does not match the path with a space", entries[0].Pattern)
+	}
+}
+
+func TestParseLineTooLong(t *testing.T) {
+	// Строка длиннее 1 МиБ останавливает разбор; уже разобранные правила остаются
+	content := "*.go @alice\n/" + strings.Repeat("a", 1<<20) + " @bob\n*.sql @carol\n"
+	entries, problems := Parse(content)
+	want := []Entry{{Line: 1, Pattern: "*.go", Owners: []string{"@alice"}}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("entries = %+v, want %+v", entries, want)
+	}
+	if len(problems) != 1 || problems[0].Line != 2 || !strings.Contains(problems[0].Message, "too long") {
+		t.Errorf("problems = %+v, want line 2 too long", problems)
+	}
+}
+
+func TestNormalizeHandle(t *testing.T) {
+	for in, want := range map[string]string{
+		"@Alice":           "alice",
+		" @Acme/Backend ":  "acme/backend",
+		"Docs@Example.com": "docs@example.com",
+		"@acme/dba":        "acme/dba",
+	} {
+		if got := NormalizeHandle(in); got != want {
+			t.Errorf("NormalizeHandle(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// Migrate применяет вшитые миграции: steps > 0 - вверх на steps, steps < 0 - вниз,
+// steps == 0 - до последней версии. Отсутствие изменений ошибкой не считается
+func (d *DB) Migrate(steps int) error {
+	source, err := iofs.New(Migrations, "migrations")
+	if err != nil {
+		return err
+	}
+	driver, err := postgres.WithInstance(d.Conn, &postgres.Config{})
+	if err != nil {
+		return err
+	}
+	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	if err != nil {
+		return err
+	}
+
+	if steps == 0 {
+		err = m.Up()
+	} else {
+		err = m.Steps(steps)
+	}
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	return nil
+}
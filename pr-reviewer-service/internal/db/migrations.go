@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// Migrations - файлы миграций, вшитые в бинарник: по ним сервис знает,
+// до какой версии должна быть накатана схема
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// ExpectedVersion - номер последней миграции в сборке
+func ExpectedVersion() (uint, error) {
+	entries, err := fs.ReadDir(Migrations, "migrations")
+	if err != nil {
+		return 0, err
+	}
+	var latest uint
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("bad migration file name %q", name)
+		}
+		if uint(v) > latest {
+			latest = uint(v)
+		}
+	}
+	return latest, nil
+}
+
+// MigrationVersion - версия схемы по таблице golang-migrate (schema_migrations)
+func (d *DB) MigrationVersion(ctx context.Context) (version uint, dirty bool, err error) {
+	err = d.Conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
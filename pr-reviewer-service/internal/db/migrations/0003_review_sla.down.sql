@@ -0,0 +1,11 @@
+-- Drop SLA tables and columns
+
+DROP INDEX IF EXISTS idx_pr_reviewers_pending;
+DROP INDEX IF EXISTS idx_review_escalations_pr_id;
+
+DROP TABLE IF EXISTS review_escalations;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS escalated_at;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS assigned_at;
+
+DROP TABLE IF EXISTS team_sla;
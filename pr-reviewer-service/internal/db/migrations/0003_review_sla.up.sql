@@ -0,0 +1,24 @@
+-- SLA ревью по командам и журнал эскалаций
+
+CREATE TABLE IF NOT EXISTS team_sla (
+    team_id INT PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    first_response_hours INT NOT NULL CHECK (first_response_hours > 0),
+    policy TEXT NOT NULL CHECK (policy IN ('ESCALATE','ADD_REVIEWER','REASSIGN')) DEFAULT 'ESCALATE'
+);
+
+-- Время назначения ревьювера и отметка о том, что просрочка уже обработана
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS review_escalations (
+    id SERIAL PRIMARY KEY,
+    pr_id INT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id INT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    policy TEXT NOT NULL,
+    new_reviewer_id INT REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_review_escalations_pr_id ON review_escalations(pr_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pending ON pr_reviewers(assigned_at) WHERE escalated_at IS NULL;
@@ -0,0 +1,5 @@
+-- Drop availability table
+
+DROP INDEX IF EXISTS idx_user_unavailability_user_id;
+
+DROP TABLE IF EXISTS user_unavailability;
@@ -0,0 +1,13 @@
+-- Периоды отсутствия пользователей (отпуск, out-of-office)
+
+CREATE TABLE IF NOT EXISTS user_unavailability (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT,
+    CHECK (ends_at > starts_at)
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_user_unavailability_user_id ON user_unavailability(user_id, ends_at);
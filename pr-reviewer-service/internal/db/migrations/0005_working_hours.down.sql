@@ -0,0 +1,6 @@
+-- Drop working hours columns
+
+ALTER TABLE users DROP COLUMN IF EXISTS work_days;
+ALTER TABLE users DROP COLUMN IF EXISTS work_end;
+ALTER TABLE users DROP COLUMN IF EXISTS work_start;
+ALTER TABLE users DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,7 @@
+-- Часовой пояс и рабочие часы пользователей
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS time_zone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_start TIME NOT NULL DEFAULT '09:00';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_end TIME NOT NULL DEFAULT '18:00';
+-- ISO-дни недели: 1 - понедельник ... 7 - воскресенье
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_days INT[] NOT NULL DEFAULT '{1,2,3,4,5}';
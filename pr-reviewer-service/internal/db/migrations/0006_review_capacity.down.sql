@@ -0,0 +1,7 @@
+-- Drop capacity columns
+
+DROP INDEX IF EXISTS idx_pull_requests_understaffed;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS understaffed;
+ALTER TABLE teams DROP COLUMN IF EXISTS default_max_open_reviews;
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
@@ -0,0 +1,8 @@
+-- Лимиты одновременных ревью и флаг неукомплектованного PR
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INT CHECK (max_open_reviews >= 0);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS default_max_open_reviews INT CHECK (default_max_open_reviews >= 0);
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS understaffed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_pull_requests_understaffed ON pull_requests(id) WHERE understaffed AND status = 'OPEN';
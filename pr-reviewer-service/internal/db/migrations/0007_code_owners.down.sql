@@ -0,0 +1,8 @@
+-- Drop code ownership tables
+
+DROP INDEX IF EXISTS idx_code_owner_rule_owners_rule_id;
+DROP INDEX IF EXISTS idx_code_owner_rules_team_id;
+
+DROP TABLE IF EXISTS pr_changed_files;
+DROP TABLE IF EXISTS code_owner_rule_owners;
+DROP TABLE IF EXISTS code_owner_rules;
@@ -0,0 +1,26 @@
+-- Правила владения путями (в стиле CODEOWNERS) и изменённые файлы PR
+
+CREATE TABLE IF NOT EXISTS code_owner_rules (
+    id SERIAL PRIMARY KEY,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    pattern TEXT NOT NULL
+);
+
+-- Владелец правила - пользователь или подгруппа (другая команда)
+CREATE TABLE IF NOT EXISTS code_owner_rule_owners (
+    rule_id INT NOT NULL REFERENCES code_owner_rules(id) ON DELETE CASCADE,
+    user_id INT REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_id INT REFERENCES teams(id) ON DELETE CASCADE,
+    CHECK ((user_id IS NULL) <> (owner_team_id IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS pr_changed_files (
+    pr_id INT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    PRIMARY KEY (pr_id, path)
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_code_owner_rules_team_id ON code_owner_rules(team_id, position);
+CREATE INDEX IF NOT EXISTS idx_code_owner_rule_owners_rule_id ON code_owner_rule_owners(rule_id);
@@ -0,0 +1,3 @@
+-- Drop handle mappings
+
+DROP TABLE IF EXISTS handle_mappings;
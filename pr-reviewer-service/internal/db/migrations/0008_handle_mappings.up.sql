@@ -0,0 +1,8 @@
+-- Соответствие владельцев из CODEOWNERS (@user, @org/team, email) пользователям и командам
+
+CREATE TABLE IF NOT EXISTS handle_mappings (
+    handle TEXT PRIMARY KEY, -- без "@", в нижнем регистре
+    user_id INT REFERENCES users(id) ON DELETE CASCADE,
+    team_id INT REFERENCES teams(id) ON DELETE CASCADE,
+    CHECK ((user_id IS NULL) <> (team_id IS NULL))
+);
@@ -0,0 +1,5 @@
+-- Drop PR list indexes
+
+DROP INDEX IF EXISTS idx_pull_requests_status;
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
@@ -0,0 +1,5 @@
+-- Индексы для списка PR с фильтрами и keyset-пагинацией
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at ON pull_requests(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at ON pull_requests(merged_at, id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status ON pull_requests(status);
@@ -0,0 +1,3 @@
+-- Drop assignment history
+
+DROP TABLE IF EXISTS pr_assignment_events;
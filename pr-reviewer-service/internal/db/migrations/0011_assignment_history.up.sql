@@ -0,0 +1,17 @@
+-- История назначений ревьюверов
+
+CREATE TABLE IF NOT EXISTS pr_assignment_events (
+    id SERIAL PRIMARY KEY,
+    pr_id INT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id INT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    action TEXT NOT NULL CHECK (action IN ('ASSIGNED','UNASSIGNED')),
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Для уже существующих назначений известен только момент назначения
+INSERT INTO pr_assignment_events(pr_id, reviewer_id, action, reason, created_at)
+SELECT pr_id, reviewer_id, 'ASSIGNED', 'BACKFILL', assigned_at FROM pr_reviewers;
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_pr_assignment_events_pr_id ON pr_assignment_events(pr_id, id);
@@ -0,0 +1,5 @@
+-- Drop SCIM external ids
+
+DROP INDEX IF EXISTS idx_users_lower_name;
+ALTER TABLE teams DROP COLUMN IF EXISTS external_id;
+ALTER TABLE users DROP COLUMN IF EXISTS external_id;
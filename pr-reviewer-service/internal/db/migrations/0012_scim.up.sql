@@ -0,0 +1,9 @@
+-- Идентификаторы пользователей и групп во внешнем каталоге (SCIM externalId)
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS external_id TEXT;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS external_id TEXT;
+
+-- Indexes
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_users_external_id ON users(external_id) WHERE external_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_teams_external_id ON teams(external_id) WHERE external_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_users_lower_name ON users(lower(name));
@@ -0,0 +1,3 @@
+-- Drop external PR links
+
+DROP TABLE IF EXISTS external_prs;
@@ -0,0 +1,9 @@
+-- Связь PR сервиса с MR/PR во внешних системах (GitLab)
+
+CREATE TABLE IF NOT EXISTS external_prs (
+    provider TEXT NOT NULL,
+    project_id BIGINT NOT NULL,
+    number INT NOT NULL, -- iid MR в проекте
+    pr_id INT NOT NULL UNIQUE REFERENCES pull_requests(id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, project_id, number)
+);
@@ -0,0 +1,5 @@
+-- Drop chat notifications
+
+DROP TABLE IF EXISTS notification_digests;
+ALTER TABLE users DROP COLUMN IF EXISTS notifications_enabled;
+DROP TABLE IF EXISTS team_webhooks;
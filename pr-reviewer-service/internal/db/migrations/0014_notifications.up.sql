@@ -0,0 +1,14 @@
+-- Уведомления в чат (Slack/Mattermost): вебхук команды, отказ пользователя и дни отправленных дайджестов
+
+CREATE TABLE IF NOT EXISTS team_webhooks (
+    team_id INT PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    url TEXT NOT NULL
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notifications_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Строка за день - дайджест уже разослан (одним экземпляром сервиса)
+CREATE TABLE IF NOT EXISTS notification_digests (
+    day DATE PRIMARY KEY,
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,8 @@
+-- Drop user email and digest channels
+
+DELETE FROM notification_digests WHERE channel <> 'chat';
+ALTER TABLE notification_digests DROP CONSTRAINT IF EXISTS notification_digests_pkey;
+ALTER TABLE notification_digests DROP COLUMN IF EXISTS channel;
+ALTER TABLE notification_digests ADD PRIMARY KEY (day);
+
+ALTER TABLE users DROP COLUMN IF EXISTS email;
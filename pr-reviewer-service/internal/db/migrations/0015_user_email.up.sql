@@ -0,0 +1,7 @@
+-- Необязательный адрес для email-дайджеста; отметки о дайджестах - отдельно по каналам
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email TEXT;
+
+ALTER TABLE notification_digests ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT 'chat';
+ALTER TABLE notification_digests DROP CONSTRAINT IF EXISTS notification_digests_pkey;
+ALTER TABLE notification_digests ADD PRIMARY KEY (channel, day);
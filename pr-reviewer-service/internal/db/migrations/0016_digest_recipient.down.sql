@@ -0,0 +1,6 @@
+-- Drop per-recipient digest marks
+
+DELETE FROM notification_digests WHERE recipient <> '';
+ALTER TABLE notification_digests DROP CONSTRAINT IF EXISTS notification_digests_pkey;
+ALTER TABLE notification_digests DROP COLUMN IF EXISTS recipient;
+ALTER TABLE notification_digests ADD PRIMARY KEY (channel, day);
@@ -0,0 +1,6 @@
+-- Отметка о дайджесте - на каждого адресата (команду или пользователя): неудачная отправка
+-- снимает только свою отметку и повторяется на следующем тике
+
+ALTER TABLE notification_digests ADD COLUMN IF NOT EXISTS recipient TEXT NOT NULL DEFAULT '';
+ALTER TABLE notification_digests DROP CONSTRAINT IF EXISTS notification_digests_pkey;
+ALTER TABLE notification_digests ADD PRIMARY KEY (channel, day, recipient);
@@ -0,0 +1,132 @@
+// Package gitlab - клиент GitLab REST API v4 и формат вебхуков merge request
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"pr-reviewer-service/internal/tracing"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Client ходит в API от имени токена (PRIVATE-TOKEN). BaseURL - адрес инстанса без /api/v4,
+// в тестах вместо GitLab можно поднять локальный фейк
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func NewClient(baseURL, token string) *Client {
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ChangedFiles - пути файлов MR (новые пути; у удалённых - старые)
+func (c *Client) ChangedFiles(ctx context.Context, projectID int64, iid int) ([]string, error) {
+	var files []string
+	for page := 1; page != 0; {
+		var diffs []struct {
+			OldPath     string `json:"old_path"`
+			NewPath     string `json:"new_path"`
+			DeletedFile bool   `json:"deleted_file"`
+		}
+		path := fmt.Sprintf("/projects/%d/merge_requests/%d/diffs?per_page=100&page=%d", projectID, iid, page)
+		header, err := c.do(ctx, http.MethodGet, path, nil, &diffs)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range diffs {
+			if d.DeletedFile {
+				files = append(files, d.OldPath)
+			} else {
+				files = append(files, d.NewPath)
+			}
+		}
+		page, _ = strconv.Atoi(header.Get("X-Next-Page"))
+	}
+	return files, nil
+}
+
+// UserID - ID пользователя GitLab по username; "not found", если такого нет
+func (c *Client) UserID(ctx context.Context, username string) (int64, error) {
+	var users []struct {
+		ID int64 `json:"id"`
+	}
+	if _, err := c.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(username), nil, &users); err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("not found")
+	}
+	return users[0].ID, nil
+}
+
+// Username - username пользователя GitLab по ID
+func (c *Client) Username(ctx context.Context, userID int64) (string, error) {
+	var user struct {
+		Username string `json:"username"`
+	}
+	if _, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%d", userID), nil, &user); err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+// SetReviewers заменяет ревьюверов MR
+func (c *Client) SetReviewers(ctx context.Context, projectID int64, iid int, reviewerIDs []int64) error {
+	body := map[string]interface{}{"reviewer_ids": reviewerIDs}
+	_, err := c.do(ctx, http.MethodPut, fmt.Sprintf("/projects/%d/merge_requests/%d", projectID, iid), body, nil)
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) (header http.Header, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GitLab "+method, attribute.String("http.path", path))
+	defer func() { tracing.End(span, err) }()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/api/v4"+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("gitlab %s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, fmt.Errorf("gitlab %s %s: %w", method, path, err)
+		}
+	}
+	return resp.Header, nil
+}
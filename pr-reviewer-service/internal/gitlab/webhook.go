@@ -0,0 +1,32 @@
+package gitlab
+
+// MergeRequestEventHeader - значение X-Gitlab-Event для событий MR
+const MergeRequestEventHeader = "Merge Request Hook"
+
+// Действия MR (object_attributes.action), на которые реагирует сервис
+const (
+	ActionOpen   = "open"
+	ActionReopen = "reopen"
+	ActionMerge  = "merge"
+)
+
+// MergeRequestEvent - нужная сервису часть вебхука merge request
+type MergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		ID                int64  `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID      int    `json:"iid"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		State    string `json:"state"`
+		AuthorID int64  `json:"author_id"`
+		URL      string `json:"url"`
+	} `json:"object_attributes"`
+}
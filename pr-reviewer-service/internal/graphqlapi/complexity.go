@@ -0,0 +1,183 @@
+package graphqlapi
+
+import (
+	"math"
+	"pr-reviewer-service/internal/services"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListSize - оценка длины списка без аргумента first (участники команды, ревьюверы)
+const defaultListSize = 10
+
+// maxCost - потолок оценки: сложение и умножение на нём останавливаются, а не переполняются
+const maxCost = math.MaxInt32
+
+// complexityWalker считает стоимость операции до выполнения: каждое поле стоит 1, поле-список
+// умножает стоимость вложенных полей на first - свой или родителя (pullRequests(first) { nodes }),
+// а без first - на defaultListSize. Заодно считается наибольшая вложенность полей
+type complexityWalker struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// measure - сложность и глубина операции; документ уже прошёл валидацию (в том числе
+// на циклы фрагментов). Операция не найдена - нули, ошибку вернёт выполнение
+func measure(schema *graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}) (complexity, depth int) {
+	w := &complexityWalker{schema: schema, fragments: map[string]*ast.FragmentDefinition{}, variables: variables}
+	var op *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			w.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				op = d
+			}
+		}
+	}
+	if op == nil {
+		return 0, 0
+	}
+	return w.selectionSet(op.SelectionSet, schema.QueryType(), 0)
+}
+
+func (w *complexityWalker) selectionSet(set *ast.SelectionSet, parent *graphql.Object, parentFirst int) (complexity, depth int) {
+	if set == nil {
+		return 0, 0
+	}
+	for _, sel := range set.Selections {
+		var c, d int
+		switch s := sel.(type) {
+		case *ast.Field:
+			c, d = w.field(s, parent, parentFirst)
+		case *ast.InlineFragment:
+			c, d = w.selectionSet(s.SelectionSet, w.condition(s.TypeCondition, parent), parentFirst)
+		case *ast.FragmentSpread:
+			if f := w.fragments[s.Name.Value]; f != nil {
+				c, d = w.selectionSet(f.SelectionSet, w.condition(f.TypeCondition, parent), parentFirst)
+			}
+		}
+		complexity = addCost(complexity, c)
+		depth = max(depth, d)
+	}
+	return complexity, depth
+}
+
+func (w *complexityWalker) field(f *ast.Field, parent *graphql.Object, parentFirst int) (complexity, depth int) {
+	if f.SelectionSet == nil {
+		return 1, 1
+	}
+	// Служебные поля (__schema, __type) в типах не описаны: считаем их без множителей
+	var def *graphql.FieldDefinition
+	if parent != nil {
+		def = parent.Fields()[f.Name.Value]
+	}
+	var object *graphql.Object
+	var list bool
+	if def != nil {
+		object, list = unwrap(def.Type)
+	}
+
+	first, hasFirst := w.first(f, def)
+	childFirst := 0
+	if hasFirst {
+		childFirst = first
+	}
+	complexity, depth = w.selectionSet(f.SelectionSet, object, childFirst)
+	if list {
+		switch {
+		case hasFirst:
+			complexity = mulCost(complexity, first)
+		case parentFirst > 0:
+			complexity = mulCost(complexity, parentFirst)
+		default:
+			complexity = mulCost(complexity, defaultListSize)
+		}
+	}
+	return addCost(1, complexity), 1 + depth
+}
+
+// first - значение аргумента first: литерал, переменная или значение по умолчанию.
+// Больше страницы резолверы не вернут, поэтому оно ограничено services.MaxPRPageSize
+func (w *complexityWalker) first(f *ast.Field, def *graphql.FieldDefinition) (int, bool) {
+	if def == nil {
+		return 0, false
+	}
+	var declared *graphql.Argument
+	for _, arg := range def.Args {
+		if arg.Name() == "first" {
+			declared = arg
+		}
+	}
+	if declared == nil {
+		return 0, false
+	}
+
+	value, _ := declared.DefaultValue.(int)
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			value, _ = strconv.Atoi(v.Value)
+		case *ast.Variable:
+			// Переменные из JSON - float64
+			switch n := w.variables[v.Name.Value].(type) {
+			case float64:
+				value = int(min(max(n, 0), services.MaxPRPageSize))
+			case int:
+				value = n
+			}
+		}
+	}
+	return min(max(value, 0), services.MaxPRPageSize), true
+}
+
+func addCost(a, b int) int {
+	if a > maxCost-b {
+		return maxCost
+	}
+	return a + b
+}
+
+func mulCost(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a > maxCost/b {
+		return maxCost
+	}
+	return a * b
+}
+
+// condition - тип из условия фрагмента; без условия - тип родителя
+func (w *complexityWalker) condition(named *ast.Named, parent *graphql.Object) *graphql.Object {
+	if named == nil {
+		return parent
+	}
+	object, _ := w.schema.Type(named.Name.Value).(*graphql.Object)
+	return object
+}
+
+// unwrap снимает NonNull и List: объектный тип (nil для скаляров) и признак списка
+func unwrap(t graphql.Type) (*graphql.Object, bool) {
+	list := false
+	for {
+		switch v := t.(type) {
+		case *graphql.NonNull:
+			t = v.OfType
+		case *graphql.List:
+			list = true
+			t = v.OfType
+		case *graphql.Object:
+			return v, list
+		default:
+			return nil, list
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package graphqlapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+func testSchema(t *testing.T) *graphql.Schema {
+	t.Helper()
+	schema, err := newSchema(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("newSchema: %v", err)
+	}
+	return &schema
+}
+
+func measureQuery(t *testing.T, schema *graphql.Schema, query string, variables map[string]interface{}) (int, int) {
+	t.Helper()
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		t.Fatalf("parse %q: %v", query, err)
+	}
+	if v := graphql.ValidateDocument(schema, doc, nil); !v.IsValid {
+		t.Fatalf("validate %q: %v", query, v.Errors)
+	}
+	return measure(schema, doc, "", variables)
+}
+
+// nestedPages - n уровней pullRequests(first) { nodes { team { ... } } }
+func nestedPages(n int, first string) string {
+	return "{ teams { " + strings.Repeat("pullRequests(first: "+first+") { nodes { team { ", n) +
+		"name" + strings.Repeat(" } } }", n) + " } }"
+}
+
+func TestMeasure(t *testing.T) {
+	schema := testSchema(t)
+	tests := []struct {
+		name       string
+		query      string
+		variables  map[string]interface{}
+		complexity int
+		depth      int
+	}{
+		{"list without first", `{ teams { name } }`, nil, 1 + defaultListSize, 2},
+		{"first from parent page", `{ pullRequests(first: 5) { nodes { id } } }`, nil, 1 + 1 + 5, 3},
+		{"default first", `{ pullRequests { nodes { id } } }`, nil, 1 + 1 + 20, 3},
+		{"first clamped to page size", `{ pullRequests(first: 2147483647) { nodes { id } } }`, nil, 1 + 1 + 100, 3},
+		{"variable clamped to page size", `query($n: Int) { pullRequests(first: $n) { nodes { id } } }`,
+			map[string]interface{}{"n": float64(1e12)}, 1 + 1 + 100, 3},
+		{"negative first counts as unknown size", `{ pullRequests(first: -5) { nodes { id } } }`, nil, 1 + 1 + defaultListSize, 3},
+		{"fragment", `{ teams { ...T } } fragment T on Team { name members { id } }`, nil, 1 + (1+(1+defaultListSize))*defaultListSize, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			complexity, depth := measureQuery(t, schema, tt.query, tt.variables)
+			if complexity != tt.complexity || depth != tt.depth {
+				t.Errorf("measure = (%d, %d), want (%d, %d)", complexity, depth, tt.complexity, tt.depth)
+			}
+		})
+	}
+}
+
+func TestMeasureSaturates(t *testing.T) {
+	schema := testSchema(t)
+	// 100^8 не помещается в int32, а при умножении без потолка переполнил бы и int64
+	complexity, _ := measureQuery(t, schema, nestedPages(8, "2147483647"), nil)
+	if complexity != maxCost {
+		t.Errorf("complexity = %d, want %d", complexity, maxCost)
+	}
+}
+
+func TestExecuteLimits(t *testing.T) {
+	s := &Server{schema: *testSchema(t), limits: Limits{MaxComplexity: 1000, MaxDepth: 6}}
+	tests := []struct {
+		name  string
+		query string
+		code  string
+	}{
+		{"too deep", `{ user(id: "u1") { team { members { team { members { team { name } } } } } } }`, "QUERY_TOO_DEEP"},
+		{"too complex", `{ pullRequests(first: 100) { nodes { reviewers { reviewer { id } } } } }`, "QUERY_TOO_COMPLEX"},
+		{"overflowing first", nestedPages(1, "2147483647"), "QUERY_TOO_COMPLEX"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := s.Execute(context.Background(), tt.query, "", nil)
+			if len(result.Errors) != 1 {
+				t.Fatalf("errors = %v, want one %s", result.Errors, tt.code)
+			}
+			if code := result.Errors[0].Extensions["code"]; code != tt.code {
+				t.Errorf("code = %v, want %s (%s)", code, tt.code, result.Errors[0].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package graphqlapi
+
+import "strings"
+
+// apiError - ошибка GraphQL с кодом HTTP API в extensions.code
+type apiError struct {
+	code    string
+	message string
+}
+
+func newError(code, message string) error {
+	return &apiError{code: code, message: message}
+}
+
+func (e *apiError) Error() string { return e.message }
+
+func (e *apiError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// serviceError - "BAD_REQUEST: ..." из сервиса отдаём клиенту как есть; прочие ошибки уже
+// залогированы репозиторием и наружу уходят без подробностей
+func serviceError(err error) error {
+	if strings.HasPrefix(err.Error(), "BAD_REQUEST") {
+		return newError("BAD_REQUEST", err.Error())
+	}
+	return newError("INTERNAL", "internal error")
+}
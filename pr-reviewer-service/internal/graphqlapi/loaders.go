@@ -0,0 +1,95 @@
+package graphqlapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// loaderWait - сколько loader копит ключи перед запросом в БД. Резолверы одного уровня
+// вызываются подряд без ожидания (значения забираются потом, см. thunk), так что
+// хватает небольшой паузы
+const loaderWait = 2 * time.Millisecond
+
+// loaders - dataloader'ы одного запроса: ключи, запрошенные резолверами одного уровня
+// вложенности, уходят в БД одним запросом. Кэш живёт до конца запроса
+type loaders struct {
+	users *dataloader.Loader[int, *models.User]
+	teams *dataloader.Loader[string, *models.Team]
+	prs   *dataloader.Loader[int, *models.PRDetails]
+	// reviewers - назначения по id PR, reviews - OPEN-ревью по id ревьювера
+	reviewers *dataloader.Loader[int, []models.ReviewAssignment]
+	reviews   *dataloader.Loader[int, []models.ReviewAssignment]
+}
+
+func newLoaders(users *repositories.UserRepository, teams *repositories.TeamRepository, prs *repositories.PRRepository) *loaders {
+	return &loaders{
+		users: dataloader.NewBatchedLoader(byKey(users.GetUsers, func(u models.User) int { return u.ID }),
+			dataloader.WithWait[int, *models.User](loaderWait)),
+		teams: dataloader.NewBatchedLoader(byKey(teams.GetTeams, func(t models.Team) string { return t.TeamName }),
+			dataloader.WithWait[string, *models.Team](loaderWait)),
+		prs: dataloader.NewBatchedLoader(byKey(prs.GetPRs, func(pr models.PRDetails) int { return pr.ID }),
+			dataloader.WithWait[int, *models.PRDetails](loaderWait)),
+		reviewers: dataloader.NewBatchedLoader(groupByKey(prs.ReviewAssignments, func(a models.ReviewAssignment) int { return a.PRID }),
+			dataloader.WithWait[int, []models.ReviewAssignment](loaderWait)),
+		reviews: dataloader.NewBatchedLoader(groupByKey(prs.OpenReviewAssignments, func(a models.ReviewAssignment) int { return a.ReviewerID }),
+			dataloader.WithWait[int, []models.ReviewAssignment](loaderWait)),
+	}
+}
+
+// byKey - BatchFunc над выборкой по ключам; для отсутствующего ключа - nil
+func byKey[K comparable, V any](fetch func(context.Context, []K) ([]V, error), key func(V) K) dataloader.BatchFunc[K, *V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[*V] {
+		items, err := fetch(ctx, keys)
+		found := make(map[K]*V, len(items))
+		for i := range items {
+			found[key(items[i])] = &items[i]
+		}
+		results := make([]*dataloader.Result[*V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[*V]{Data: found[k], Error: err}
+		}
+		return results
+	}
+}
+
+// groupByKey - BatchFunc для связи один-ко-многим; порядок строк выборки сохраняется
+func groupByKey[K comparable, V any](fetch func(context.Context, []K) ([]V, error), key func(V) K) dataloader.BatchFunc[K, []V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[[]V] {
+		items, err := fetch(ctx, keys)
+		groups := make(map[K][]V, len(keys))
+		for _, item := range items {
+			groups[key(item)] = append(groups[key(item)], item)
+		}
+		results := make([]*dataloader.Result[[]V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[[]V]{Data: groups[k], Error: err}
+		}
+		return results
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// thunk - значение из loader'а в виде, который graphql-go вычисляет после обхода
+// всего уровня: к этому моменту ключи соседних полей уже собраны в один batch
+func thunk[V any](load dataloader.Thunk[V], convert func(V) interface{}) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		v, err := load()
+		if err != nil {
+			return nil, serviceError(err)
+		}
+		return convert(v), nil
+	}
+}
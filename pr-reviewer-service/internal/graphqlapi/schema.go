@@ -0,0 +1,425 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/services"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+)
+
+// Источники (Source) резолверов: Team - имя команды (string), User - *models.User,
+// PullRequest - *models.PullRequest, ReviewAssignment - models.ReviewAssignment,
+// PullRequestPage - *models.PRListPage, Stats - *models.ReviewStats
+
+// pageArgs - аргументы страницы списка PR
+func pageArgs(status *graphql.Enum, extra graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"status": &graphql.ArgumentConfig{Type: status},
+		"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20, Description: "Размер страницы, не больше 100"},
+		"after":  &graphql.ArgumentConfig{Type: graphql.String, Description: "nextCursor предыдущей страницы"},
+	}
+	for name, arg := range extra {
+		args[name] = arg
+	}
+	return args
+}
+
+// parseID - идентификатор в числовом виде ("3") или в формате API ("u3", "pr-3")
+func parseID(field string, value interface{}, prefix string) (int, error) {
+	s, _ := value.(string)
+	id, err := strconv.Atoi(strings.TrimPrefix(s, prefix))
+	if err != nil || id <= 0 {
+		return 0, newError("BAD_REQUEST", fmt.Sprintf("invalid %s %q", field, s))
+	}
+	return id, nil
+}
+
+func userID(id int) string { return fmt.Sprintf("u%d", id) }
+
+func newSchema(prService *services.PRService, statsService *services.StatsService, teamService *services.TeamService) (graphql.Schema, error) {
+	status := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestStatus",
+		Values: graphql.EnumValueConfigMap{
+			"OPEN":   &graphql.EnumValueConfig{Value: "OPEN"},
+			"MERGED": &graphql.EnumValueConfig{Value: "MERGED"},
+		},
+	})
+
+	var team, user, pullRequest, assignment, page *graphql.Object
+
+	// listPRs - страница PR; фильтр команды/автора/ревьювера задаёт вызывающий резолвер
+	listPRs := func(p graphql.ResolveParams, filter models.PRListFilter) (interface{}, error) {
+		filter.Status, _ = p.Args["status"].(string)
+		filter.Limit, _ = p.Args["first"].(int)
+		filter.Cursor, _ = p.Args["after"].(string)
+		// Без курсора first=0 означал бы размер по умолчанию - для GraphQL это ошибка аргумента
+		if filter.Limit <= 0 {
+			return nil, newError("BAD_REQUEST", "first must be positive")
+		}
+		result, err := prService.ListPRs(p.Context, filter)
+		if err != nil {
+			logger.FromContext(p.Context).Warn("Failed to list PRs", zap.Error(err))
+			return nil, serviceError(err)
+		}
+		return result, nil
+	}
+
+	team = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Team",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(string), nil },
+				},
+				"members": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(user))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						name := p.Source.(string)
+						return thunk(loadersFrom(p.Context).teams.Load(p.Context, name), func(t *models.Team) interface{} {
+							members := []*models.User{}
+							if t != nil {
+								for _, m := range t.Members {
+									members = append(members, &models.User{ID: m.UserID, Username: m.Username, TeamName: name, IsActive: m.IsActive})
+								}
+							}
+							return members
+						}), nil
+					},
+				},
+				"pullRequests": &graphql.Field{
+					Type: graphql.NewNonNull(page),
+					Args: pageArgs(status, nil),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return listPRs(p, models.PRListFilter{TeamName: p.Source.(string)})
+					},
+				},
+			}
+		}),
+	})
+
+	user = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return userID(p.Source.(*models.User).ID), nil },
+				},
+				"username": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(*models.User).Username, nil },
+				},
+				"isActive": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(*models.User).IsActive, nil },
+				},
+				"team": &graphql.Field{
+					Type: team,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if name := p.Source.(*models.User).TeamName; name != "" {
+							return name, nil
+						}
+						return nil, nil
+					},
+				},
+				"openReviews": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(assignment))),
+					Description: "Назначения на OPEN PR, старые первыми",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						id := p.Source.(*models.User).ID
+						return thunk(loadersFrom(p.Context).reviews.Load(p.Context, id), func(a []models.ReviewAssignment) interface{} {
+							if a == nil {
+								return []models.ReviewAssignment{}
+							}
+							return a
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	pullRequest = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PullRequest",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return fmt.Sprintf("pr-%d", p.Source.(*models.PullRequest).ID), nil
+					},
+				},
+				"title": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(*models.PullRequest).Title, nil },
+				},
+				"status": &graphql.Field{
+					Type:    graphql.NewNonNull(status),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(*models.PullRequest).Status, nil },
+				},
+				"understaffed": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*models.PullRequest).Understaffed, nil
+					},
+				},
+				"createdAt": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.DateTime),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*models.PullRequest).CreatedAt, nil
+					},
+				},
+				"mergedAt": &graphql.Field{
+					Type: graphql.DateTime,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if t := p.Source.(*models.PullRequest).MergedAt; t != nil {
+							return *t, nil
+						}
+						return nil, nil
+					},
+				},
+				"author": &graphql.Field{
+					Type: graphql.NewNonNull(user),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadUser(p, p.Source.(*models.PullRequest).AuthorID), nil
+					},
+				},
+				"team": &graphql.Field{
+					Type: graphql.NewNonNull(team),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						id := p.Source.(*models.PullRequest).ID
+						return thunk(loadersFrom(p.Context).prs.Load(p.Context, id), func(pr *models.PRDetails) interface{} {
+							if pr == nil {
+								return nil
+							}
+							return pr.TeamName
+						}), nil
+					},
+				},
+				"reviewers": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(assignment))),
+					Description: "Текущие ревьюверы по времени назначения",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						id := p.Source.(*models.PullRequest).ID
+						return thunk(loadersFrom(p.Context).reviewers.Load(p.Context, id), func(a []models.ReviewAssignment) interface{} {
+							if a == nil {
+								return []models.ReviewAssignment{}
+							}
+							return a
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	assignment = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReviewAssignment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"reviewer": &graphql.Field{
+					Type: graphql.NewNonNull(user),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadUser(p, p.Source.(models.ReviewAssignment).ReviewerID), nil
+					},
+				},
+				"pullRequest": &graphql.Field{
+					Type: graphql.NewNonNull(pullRequest),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadPR(p, p.Source.(models.ReviewAssignment).PRID), nil
+					},
+				},
+				"assignedAt": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.DateTime),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(models.ReviewAssignment).AssignedAt, nil
+					},
+				},
+			}
+		}),
+	})
+
+	page = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PullRequestPage",
+		Fields: graphql.Fields{
+			"nodes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(pullRequest))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					prs := p.Source.(*models.PRListPage).PullRequests
+					nodes := make([]*models.PullRequest, len(prs))
+					for i := range prs {
+						nodes[i] = &prs[i]
+					}
+					return nodes, nil
+				},
+			},
+			"nextCursor": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Курсор следующей страницы; null на последней",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if c := p.Source.(*models.PRListPage).NextCursor; c != "" {
+						return c, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	reviewerLoad := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReviewerLoad",
+		Fields: graphql.Fields{
+			"reviewer": &graphql.Field{
+				Type: graphql.NewNonNull(user),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadUser(p, p.Source.(models.ReviewerLoad).UserID), nil
+				},
+			},
+			"openReviews": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.ReviewerLoad).OpenReviews, nil
+				},
+			},
+		},
+	})
+
+	statsField := func(t graphql.Output, get func(*models.ReviewStats) interface{}) *graphql.Field {
+		return &graphql.Field{
+			Type:    graphql.NewNonNull(t),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) { return get(p.Source.(*models.ReviewStats)), nil },
+		}
+	}
+	stats := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Stats",
+		Fields: graphql.Fields{
+			"teams":                    statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.Teams }),
+			"users":                    statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.Users }),
+			"activeUsers":              statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.ActiveUsers }),
+			"openPullRequests":         statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.OpenPRs }),
+			"mergedPullRequests":       statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.MergedPRs }),
+			"understaffedPullRequests": statsField(graphql.Int, func(s *models.ReviewStats) interface{} { return s.UnderstaffedPRs }),
+			"avgMergeHours":            statsField(graphql.Float, func(s *models.ReviewStats) interface{} { return s.AvgMergeHours }),
+			"reviewerLoad": statsField(graphql.NewList(graphql.NewNonNull(reviewerLoad)),
+				func(s *models.ReviewStats) interface{} { return s.ReviewerLoad }),
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": &graphql.Field{
+				Type: team,
+				Args: graphql.FieldConfigArgument{"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					name := p.Args["name"].(string)
+					return thunk(loadersFrom(p.Context).teams.Load(p.Context, name), func(t *models.Team) interface{} {
+						if t == nil {
+							return nil
+						}
+						return t.TeamName
+					}), nil
+				},
+			},
+			"teams": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(team))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					summaries, err := teamService.ListTeams(p.Context)
+					if err != nil {
+						return nil, serviceError(err)
+					}
+					names := make([]string, 0, len(summaries))
+					for _, t := range summaries {
+						names = append(names, t.TeamName)
+					}
+					return names, nil
+				},
+			},
+			"user": &graphql.Field{
+				Type: user,
+				Args: graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID("id", p.Args["id"], "u")
+					if err != nil {
+						return nil, err
+					}
+					return loadUser(p, id), nil
+				},
+			},
+			"pullRequest": &graphql.Field{
+				Type: pullRequest,
+				Args: graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID("id", p.Args["id"], "pr-")
+					if err != nil {
+						return nil, err
+					}
+					return loadPR(p, id), nil
+				},
+			},
+			"pullRequests": &graphql.Field{
+				Type: graphql.NewNonNull(page),
+				Args: pageArgs(status, graphql.FieldConfigArgument{
+					"team":     &graphql.ArgumentConfig{Type: graphql.String},
+					"author":   &graphql.ArgumentConfig{Type: graphql.ID},
+					"reviewer": &graphql.ArgumentConfig{Type: graphql.ID},
+					"query":    &graphql.ArgumentConfig{Type: graphql.String, Description: "Подстрока в названии"},
+				}),
+				Description: "PR по фильтрам, новые сверху",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					filter := models.PRListFilter{}
+					filter.TeamName, _ = p.Args["team"].(string)
+					filter.Query, _ = p.Args["query"].(string)
+					var err error
+					if v, ok := p.Args["author"].(string); ok {
+						if filter.AuthorID, err = parseID("author", v, "u"); err != nil {
+							return nil, err
+						}
+					}
+					if v, ok := p.Args["reviewer"].(string); ok {
+						if filter.ReviewerID, err = parseID("reviewer", v, "u"); err != nil {
+							return nil, err
+						}
+					}
+					return listPRs(p, filter)
+				},
+			},
+			"stats": &graphql.Field{
+				Type: graphql.NewNonNull(stats),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					s, err := statsService.GetStats(p.Context)
+					if err != nil {
+						return nil, serviceError(err)
+					}
+					return s, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func loadUser(p graphql.ResolveParams, id int) func() (interface{}, error) {
+	return thunk(loadersFrom(p.Context).users.Load(p.Context, id), func(u *models.User) interface{} {
+		if u == nil {
+			return nil
+		}
+		return u
+	})
+}
+
+func loadPR(p graphql.ResolveParams, id int) func() (interface{}, error) {
+	return thunk(loadersFrom(p.Context).prs.Load(p.Context, id), func(pr *models.PRDetails) interface{} {
+		if pr == nil {
+			return nil
+		}
+		return &pr.PullRequest
+	})
+}
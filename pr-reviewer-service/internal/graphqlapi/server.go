@@ -0,0 +1,80 @@
+// Package graphqlapi - GraphQL только для чтения (POST /graphql) для дашбордов: команды,
+// пользователи, PR и назначения одним запросом. Связанные объекты подгружаются
+// dataloader'ами пачками, дорогие запросы отсекаются по сложности и глубине до выполнения
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/repositories"
+	"pr-reviewer-service/internal/services"
+	"pr-reviewer-service/internal/tracing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Limits - ограничения запроса; 0 - без ограничения
+type Limits struct {
+	MaxComplexity int
+	MaxDepth      int
+}
+
+type Server struct {
+	schema graphql.Schema
+	limits Limits
+
+	userRepo *repositories.UserRepository
+	teamRepo *repositories.TeamRepository
+	prRepo   *repositories.PRRepository
+}
+
+func NewServer(userRepo *repositories.UserRepository, teamRepo *repositories.TeamRepository, prRepo *repositories.PRRepository,
+	prService *services.PRService, teamService *services.TeamService, statsService *services.StatsService, limits Limits) (*Server, error) {
+	schema, err := newSchema(prService, statsService, teamService)
+	if err != nil {
+		return nil, fmt.Errorf("build GraphQL schema: %w", err)
+	}
+	return &Server{schema: schema, limits: limits, userRepo: userRepo, teamRepo: teamRepo, prRepo: prRepo}, nil
+}
+
+// Execute - разбор, валидация, проверка лимитов и выполнение запроса. Ошибки
+// возвращаются в Result.Errors, как требует GraphQL
+func (s *Server) Execute(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Result {
+	ctx, span := tracing.StartSpan(ctx, "GraphQL", attribute.String("graphql.operation.name", operationName))
+	defer span.End()
+
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query), Name: "GraphQL request"})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if v := graphql.ValidateDocument(&s.schema, doc, nil); !v.IsValid {
+		return &graphql.Result{Errors: v.Errors}
+	}
+
+	complexity, depth := measure(&s.schema, doc, operationName, variables)
+	span.SetAttributes(attribute.Int("graphql.complexity", complexity), attribute.Int("graphql.depth", depth))
+	if s.limits.MaxDepth > 0 && depth > s.limits.MaxDepth {
+		return limitError("QUERY_TOO_DEEP", fmt.Sprintf("query depth %d exceeds limit %d", depth, s.limits.MaxDepth))
+	}
+	if s.limits.MaxComplexity > 0 && complexity > s.limits.MaxComplexity {
+		return limitError("QUERY_TOO_COMPLEX", fmt.Sprintf("query complexity %d exceeds limit %d", complexity, s.limits.MaxComplexity))
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: operationName,
+		Args:          variables,
+		Context:       withLoaders(ctx, newLoaders(s.userRepo, s.teamRepo, s.prRepo)),
+	})
+}
+
+func limitError(code, message string) *graphql.Result {
+	err := gqlerrors.NewFormattedError(message)
+	err.Extensions = map[string]interface{}{"code": code}
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{err}}
+}
@@ -0,0 +1,119 @@
+package grpcapi
+
+import (
+	"fmt"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseID - ID в формате API ("u3", "pr-3") или числом
+func parseID(field, value, prefix string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(value, prefix))
+	if err != nil || id <= 0 {
+		v := &models.ValidationError{}
+		v.Add(field, fmt.Sprintf("invalid id %q", value))
+		return 0, validationError(v)
+	}
+	return id, nil
+}
+
+func userID(id int) string { return fmt.Sprintf("u%d", id) }
+
+func prID(id int) string { return fmt.Sprintf("pr-%d", id) }
+
+func toStatus(s string) reviewerv1.PullRequestStatus {
+	switch s {
+	case "OPEN":
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case "MERGED":
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	}
+	return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+// fromStatus - статус для фильтра; UNSPECIFIED - без фильтра
+func fromStatus(s reviewerv1.PullRequestStatus) string {
+	switch s {
+	case reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return "OPEN"
+	case reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return "MERGED"
+	}
+	return ""
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toTeam(t *models.Team) *reviewerv1.Team {
+	team := &reviewerv1.Team{TeamName: t.TeamName}
+	for _, m := range t.Members {
+		team.Members = append(team.Members, &reviewerv1.TeamMember{UserId: userID(m.UserID), Username: m.Username, IsActive: m.IsActive})
+	}
+	return team
+}
+
+func fromTeam(t *reviewerv1.Team) (*models.Team, error) {
+	team := &models.Team{TeamName: t.GetTeamName()}
+	for _, m := range t.GetMembers() {
+		id, err := parseID("user_id", m.GetUserId(), "u")
+		if err != nil {
+			return nil, err
+		}
+		team.Members = append(team.Members, models.TeamMember{UserID: id, Username: m.GetUsername(), IsActive: m.GetIsActive()})
+	}
+	return team, nil
+}
+
+func toUser(u *models.User) *reviewerv1.User {
+	return &reviewerv1.User{UserId: userID(u.ID), Username: u.Username, TeamName: u.TeamName, IsActive: u.IsActive}
+}
+
+func toPullRequest(pr *models.PullRequest) *reviewerv1.PullRequest {
+	out := &reviewerv1.PullRequest{
+		PullRequestId:   prID(pr.ID),
+		PullRequestName: pr.Title,
+		AuthorId:        userID(pr.AuthorID),
+		Status:          toStatus(pr.Status),
+		CreatedAt:       timestamp(&pr.CreatedAt),
+		MergedAt:        timestamp(pr.MergedAt),
+		Understaffed:    pr.Understaffed,
+	}
+	for _, r := range pr.AssignedReviewers {
+		out.AssignedReviewers = append(out.AssignedReviewers, userID(r))
+	}
+	return out
+}
+
+func toPullRequests(prs []models.PullRequest) []*reviewerv1.PullRequest {
+	out := make([]*reviewerv1.PullRequest, 0, len(prs))
+	for i := range prs {
+		out = append(out, toPullRequest(&prs[i]))
+	}
+	return out
+}
+
+// parseSort - sort как в query-параметре: "-created_at" - по убыванию
+func parseSort(f *models.PRListFilter, sort string) {
+	if sort != "" {
+		f.Desc = strings.HasPrefix(sort, "-")
+		f.Sort = strings.TrimPrefix(sort, "-")
+	}
+}
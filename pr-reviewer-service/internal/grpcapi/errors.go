@@ -0,0 +1,79 @@
+package grpcapi
+
+import (
+	"errors"
+	"pr-reviewer-service/internal/models"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain - domain в google.rpc.ErrorInfo
+const errorDomain = "pr-reviewer-service"
+
+// Коды ошибок HTTP API и соответствующие им коды gRPC
+var errorCodes = map[string]codes.Code{
+	"BAD_REQUEST":  codes.InvalidArgument,
+	"NOT_FOUND":    codes.NotFound,
+	"TEAM_EXISTS":  codes.AlreadyExists,
+	"PR_EXISTS":    codes.AlreadyExists,
+	"CONFLICT":     codes.AlreadyExists,
+	"PR_MERGED":    codes.FailedPrecondition,
+	"NOT_ASSIGNED": codes.FailedPrecondition,
+	"NO_CANDIDATE": codes.FailedPrecondition,
+	"UNAUTHORIZED": codes.Unauthenticated,
+	"FORBIDDEN":    codes.PermissionDenied,
+	"INTERNAL":     codes.Internal,
+}
+
+// newError - статус gRPC с кодом HTTP API в ErrorInfo.Reason
+func newError(code, message string) error {
+	grpcCode, ok := errorCodes[code]
+	if !ok {
+		grpcCode = codes.Unknown
+	}
+	st := status.New(grpcCode, message)
+	if withDetails, err := st.WithDetails(&errdetails.ErrorInfo{Reason: code, Domain: errorDomain}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// validationError - INVALID_ARGUMENT с google.rpc.BadRequest: по нарушению на поле
+func validationError(v *models.ValidationError) error {
+	st := status.New(codes.InvalidArgument, v.Message())
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(v.Fields))
+	for _, f := range v.Fields {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: f.Field, Description: f.Message})
+	}
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "BAD_REQUEST", Domain: errorDomain},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// serviceError переводит ошибку сервиса в статус: *models.ValidationError - INVALID_ARGUMENT
+// с полями, "not found" - NOT_FOUND, "CODE: ..." - этот код, остальное - fallback (тот же
+// код, что отдаёт соответствующий HTTP handler)
+func serviceError(err error, fallback string) error {
+	var v *models.ValidationError
+	if errors.As(err, &v) {
+		return validationError(v)
+	}
+	msg := err.Error()
+	if msg == "not found" {
+		return newError("NOT_FOUND", msg)
+	}
+	if code, _, ok := strings.Cut(msg, ": "); ok {
+		if _, known := errorCodes[code]; known {
+			return newError(code, msg)
+		}
+	}
+	return newError(fallback, msg)
+}
@@ -0,0 +1,140 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/tracing"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Роли методов - те же, что у соответствующих HTTP-маршрутов
+var methodRoles = map[string][]string{
+	reviewerv1.TeamService_AddTeam_FullMethodName:                  middleware.AdminRoles,
+	reviewerv1.TeamService_GetTeam_FullMethodName:                  middleware.ReaderRoles,
+	reviewerv1.UserService_SetIsActive_FullMethodName:              middleware.AdminRoles,
+	reviewerv1.UserService_GetReview_FullMethodName:                middleware.ReaderRoles,
+	reviewerv1.PullRequestService_CreatePullRequest_FullMethodName: middleware.BotRoles,
+	reviewerv1.PullRequestService_MergePullRequest_FullMethodName:  middleware.BotRoles,
+	reviewerv1.PullRequestService_GetPullRequest_FullMethodName:    middleware.ReaderRoles,
+	reviewerv1.PullRequestService_ListPullRequests_FullMethodName:  middleware.ReaderRoles,
+	reviewerv1.PullRequestService_ReassignReviewer_FullMethodName:  middleware.ReviewerRoles,
+}
+
+// Служебные сервисы без аутентификации: health-проверки и reflection
+var publicPrefixes = []string{"/grpc.health.v1.", "/grpc.reflection."}
+
+// metadataCarrier - metadata gRPC для propagation (traceparent)
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) { metadata.MD(c).Set(key, value) }
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// observe - спан, логгер с request_id и строка лога на каждый вызов (как RequestLogger в HTTP)
+func observe(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	ctx, span := tracing.Tracer().Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc")))
+	defer span.End()
+
+	requestID := middleware.RequestID(metadataCarrier(md).Get("x-request-id"))
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", requestID))
+	ctx = logger.WithContext(ctx, logger.Logger.With(zap.String("request_id", requestID)))
+
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", code.String()))
+	fields := []zap.Field{
+		zap.String("method", info.FullMethod),
+		zap.String("code", code.String()),
+		zap.Duration("duration", time.Since(start)),
+	}
+	log := logger.FromContext(ctx)
+	switch code {
+	case codes.OK:
+		log.Info("gRPC request", fields...)
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		span.SetStatus(otelcodes.Error, code.String())
+		log.Error("gRPC request", fields...)
+	default:
+		log.Warn("gRPC request", fields...)
+	}
+	return resp, err
+}
+
+// authorize - те же учётные данные, что в HTTP: x-api-key или authorization: Bearer
+func authorize(auths []middleware.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, prefix := range publicPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+		roles, ok := methodRoles[info.FullMethod]
+		if !ok {
+			return nil, newError("FORBIDDEN", "method is not allowed")
+		}
+
+		raw := credentials(ctx)
+		if raw == "" {
+			return nil, newError("UNAUTHORIZED", "API key required")
+		}
+		principal, err := middleware.Resolve(ctx, auths, raw)
+		if errors.Is(err, models.ErrAuthUnavailable) {
+			logger.FromContext(ctx).Error("Failed to check credentials", zap.Error(err), zap.String("method", info.FullMethod))
+			return nil, newError("INTERNAL", "failed to check credentials")
+		}
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to authenticate request", zap.Error(err), zap.String("method", info.FullMethod))
+			return nil, newError("UNAUTHORIZED", err.Error())
+		}
+		if !middleware.HasRole(principal, roles...) {
+			logger.FromContext(ctx).Warn("Access denied", zap.String("principal", principal.Name), zap.String("role", principal.Role), zap.String("method", info.FullMethod))
+			return nil, newError("FORBIDDEN", "role "+principal.Role+" is not allowed to call this method")
+		}
+		return handler(middleware.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func credentials(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if key := metadataCarrier(md).Get("x-api-key"); key != "" {
+		return key
+	}
+	if h := metadataCarrier(md).Get("authorization"); len(h) > 7 && strings.EqualFold(h[:7], "Bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+package grpcapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/services"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type pullRequestServer struct {
+	reviewerv1.UnimplementedPullRequestServiceServer
+	svc *services.PRService
+}
+
+func (s *pullRequestServer) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.CreatePullRequestResponse, error) {
+	authorID, err := parseID("author_id", req.GetAuthorId(), "u")
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.svc.CreatePR(ctx, req.GetPullRequestName(), authorID, int(req.GetTeamId()), req.GetChangedFiles())
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to create PR", zap.Error(err), zap.Int("author_id", authorID))
+		return nil, serviceError(err, "INTERNAL")
+	}
+	return &reviewerv1.CreatePullRequestResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *pullRequestServer) MergePullRequest(ctx context.Context, req *reviewerv1.MergePullRequestRequest) (*reviewerv1.MergePullRequestResponse, error) {
+	id, err := parseID("pull_request_id", req.GetPullRequestId(), "pr-")
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.svc.MergePR(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to merge PR", zap.Error(err), zap.Int("pr_id", id))
+		return nil, serviceError(err, "NOT_FOUND")
+	}
+	return &reviewerv1.MergePullRequestResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *pullRequestServer) GetPullRequest(ctx context.Context, req *reviewerv1.GetPullRequestRequest) (*reviewerv1.GetPullRequestResponse, error) {
+	id, err := parseID("pull_request_id", req.GetPullRequestId(), "pr-")
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.svc.GetPRDetails(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR", zap.Error(err), zap.Int("pr_id", id))
+		return nil, serviceError(err, "INTERNAL")
+	}
+
+	resp := &reviewerv1.GetPullRequestResponse{Pr: toPullRequest(&details.PullRequest), TeamName: details.TeamName}
+	for _, r := range details.Reviewers {
+		resp.Reviewers = append(resp.Reviewers, &reviewerv1.Reviewer{
+			UserId: userID(r.UserID), Username: r.Username, AssignedAt: timestamppb.New(r.AssignedAt),
+		})
+	}
+	for _, e := range details.History {
+		resp.History = append(resp.History, &reviewerv1.AssignmentEvent{
+			ReviewerId: userID(e.ReviewerID), Username: e.Username, Action: e.Action, Reason: e.Reason, At: timestamppb.New(e.At),
+		})
+	}
+	return resp, nil
+}
+
+func (s *pullRequestServer) ListPullRequests(ctx context.Context, req *reviewerv1.ListPullRequestsRequest) (*reviewerv1.ListPullRequestsResponse, error) {
+	filter := models.PRListFilter{
+		Status:      fromStatus(req.GetStatus()),
+		TeamName:    req.GetTeamName(),
+		CreatedFrom: fromTimestamp(req.GetCreatedFrom()),
+		CreatedTo:   fromTimestamp(req.GetCreatedTo()),
+		MergedFrom:  fromTimestamp(req.GetMergedFrom()),
+		MergedTo:    fromTimestamp(req.GetMergedTo()),
+		Query:       req.GetQ(),
+		Limit:       int(req.GetLimit()),
+		Cursor:      req.GetCursor(),
+	}
+	parseSort(&filter, req.GetSort())
+
+	var err error
+	if v := req.GetAuthorId(); v != "" {
+		if filter.AuthorID, err = parseID("author_id", v, "u"); err != nil {
+			return nil, err
+		}
+	}
+	if v := req.GetReviewerId(); v != "" {
+		if filter.ReviewerID, err = parseID("reviewer_id", v, "u"); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := s.svc.ListPRs(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list PRs", zap.Error(err))
+		return nil, serviceError(err, "INTERNAL")
+	}
+	return &reviewerv1.ListPullRequestsResponse{PullRequests: toPullRequests(page.PullRequests), NextCursor: page.NextCursor}, nil
+}
+
+func (s *pullRequestServer) ReassignReviewer(ctx context.Context, req *reviewerv1.ReassignReviewerRequest) (*reviewerv1.ReassignReviewerResponse, error) {
+	id, err := parseID("pull_request_id", req.GetPullRequestId(), "pr-")
+	if err != nil {
+		return nil, err
+	}
+	oldUserID, err := parseID("old_user_id", req.GetOldUserId(), "u")
+	if err != nil {
+		return nil, err
+	}
+
+	// Пользователь по JWT может переназначить только собственное ревью
+	if p := middleware.PrincipalFrom(ctx); p != nil && p.Role == models.RoleReviewer && p.UserID != oldUserID {
+		return nil, newError("FORBIDDEN", "reviewers can reassign only their own reviews")
+	}
+
+	pr, newReviewerID, err := s.svc.ReassignReviewer(ctx, id, oldUserID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to reassign reviewer", zap.Error(err), zap.Int("pr_id", id), zap.Int("old_user_id", oldUserID))
+		return nil, serviceError(err, "NO_CANDIDATE")
+	}
+	return &reviewerv1.ReassignReviewerResponse{Pr: toPullRequest(pr), ReplacedBy: userID(newReviewerID)}, nil
+}
@@ -0,0 +1,58 @@
+// Package grpcapi - gRPC API поверх тех же сервисов, что и HTTP (proto/reviewer/v1).
+// Код в reviewerv1 генерируется: make proto
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/services"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Server - gRPC-сервер с health-проверкой (grpc.health.v1) и reflection
+type Server struct {
+	grpc   *grpc.Server
+	health *health.Server
+}
+
+func NewServer(auths []middleware.Authenticator, teams *services.TeamService, users *services.UserService, prs *services.PRService) *Server {
+	s := &Server{
+		grpc:   grpc.NewServer(grpc.ChainUnaryInterceptor(observe, authorize(auths))),
+		health: health.NewServer(),
+	}
+	reviewerv1.RegisterTeamServiceServer(s.grpc, &teamServer{svc: teams})
+	reviewerv1.RegisterUserServiceServer(s.grpc, &userServer{svc: users})
+	reviewerv1.RegisterPullRequestServiceServer(s.grpc, &pullRequestServer{svc: prs})
+	healthpb.RegisterHealthServer(s.grpc, s.health)
+	reflection.Register(s.grpc)
+	return s
+}
+
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpc.Serve(lis)
+}
+
+// SetDraining - health отвечает NOT_SERVING, как /health/ready после SIGTERM
+func (s *Server) SetDraining() {
+	s.health.Shutdown()
+}
+
+// Shutdown дожидается текущих вызовов; по истечении ctx обрывает их
+func (s *Server) Shutdown(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.grpc.Stop()
+	}
+}
@@ -0,0 +1,36 @@
+package grpcapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/services"
+
+	"go.uber.org/zap"
+)
+
+type teamServer struct {
+	reviewerv1.UnimplementedTeamServiceServer
+	svc *services.TeamService
+}
+
+func (s *teamServer) AddTeam(ctx context.Context, req *reviewerv1.AddTeamRequest) (*reviewerv1.AddTeamResponse, error) {
+	team, err := fromTeam(req.GetTeam())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.AddTeam(ctx, team); err != nil {
+		logger.FromContext(ctx).Error("Failed to add team", zap.Error(err), zap.String("team_name", team.TeamName))
+		return nil, serviceError(err, "TEAM_EXISTS")
+	}
+	return &reviewerv1.AddTeamResponse{Team: toTeam(team)}, nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.GetTeamResponse, error) {
+	team, err := s.svc.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		logger.FromContext(ctx).Warn("Team not found", zap.String("team_name", req.GetTeamName()), zap.Error(err))
+		return nil, newError("NOT_FOUND", "team '"+req.GetTeamName()+"' not found")
+	}
+	return &reviewerv1.GetTeamResponse{Team: toTeam(team)}, nil
+}
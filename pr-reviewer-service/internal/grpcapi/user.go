@@ -0,0 +1,58 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/services"
+
+	"go.uber.org/zap"
+)
+
+type userServer struct {
+	reviewerv1.UnimplementedUserServiceServer
+	svc *services.UserService
+}
+
+func (s *userServer) SetIsActive(ctx context.Context, req *reviewerv1.SetIsActiveRequest) (*reviewerv1.SetIsActiveResponse, error) {
+	id, err := parseID("user_id", req.GetUserId(), "u")
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.svc.SetIsActive(ctx, id, req.GetIsActive())
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to set user active status", zap.Error(err), zap.Int("user_id", id))
+		return nil, newError("NOT_FOUND", fmt.Sprintf("user u%d not found", id))
+	}
+	return &reviewerv1.SetIsActiveResponse{User: toUser(user)}, nil
+}
+
+func (s *userServer) GetReview(ctx context.Context, req *reviewerv1.GetReviewRequest) (*reviewerv1.GetReviewResponse, error) {
+	raw := req.GetUserId()
+	// Без user_id (или с "me") - ревью текущего пользователя из JWT
+	if raw == "" || raw == "me" {
+		if p := middleware.PrincipalFrom(ctx); p != nil && p.UserID != 0 {
+			raw = userID(p.UserID)
+		}
+	}
+	id, err := parseID("user_id", raw, "u")
+	if err != nil {
+		return nil, err
+	}
+
+	filter := models.PRListFilter{Status: fromStatus(req.GetStatus()), Limit: int(req.GetLimit()), Cursor: req.GetCursor()}
+	parseSort(&filter, req.GetSort())
+	page, err := s.svc.GetReview(ctx, id, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get assigned PRs", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "NOT_FOUND")
+	}
+	return &reviewerv1.GetReviewResponse{
+		UserId:       userID(id),
+		PullRequests: toPullRequests(page.PullRequests),
+		NextCursor:   page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package httpapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) GetLogLevel(ctx context.Context, request openapi.GetLogLevelRequestObject) (openapi.GetLogLevelResponseObject, error) {
+	return openapi.GetLogLevel200JSONResponse{Level: openapi.LogLevelLevel(logger.Level())}, nil
+}
+
+// SetLogLevel - уровень меняется сразу для всех логгеров, в том числе уже созданных логгеров запросов
+func (s *Server) SetLogLevel(ctx context.Context, request openapi.SetLogLevelRequestObject) (openapi.SetLogLevelResponseObject, error) {
+	previous := logger.Level()
+	if err := logger.SetLevel(request.Body.Level); err != nil {
+		return nil, fieldError("level", err.Error())
+	}
+
+	logger.FromContext(ctx).Warn("Log level changed", zap.String("from", previous), zap.String("to", logger.Level()))
+	return openapi.SetLogLevel200JSONResponse{Level: openapi.LogLevelLevel(logger.Level())}, nil
+}
+
+func (s *Server) CreateAPIKey(ctx context.Context, request openapi.CreateAPIKeyRequestObject) (openapi.CreateAPIKeyResponseObject, error) {
+	body := request.Body
+	raw, key, err := s.apiKeys.CreateKey(ctx, body.Name, string(body.Role))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to create API key", zap.Error(err), zap.String("name", body.Name))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	logger.FromContext(ctx).Info("API key created", zap.Int("id", key.ID), zap.String("name", key.Name), zap.String("role", key.Role))
+	// Ключ показывается один раз: в БД хранится только его хэш
+	return openapi.CreateAPIKey201JSONResponse{ApiKey: toAPIKey(key), Key: raw}, nil
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, request openapi.ListAPIKeysRequestObject) (openapi.ListAPIKeysResponseObject, error) {
+	keys, err := s.apiKeys.ListKeys(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list API keys", zap.Error(err))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	resp := openapi.ListAPIKeys200JSONResponse{ApiKeys: make([]openapi.APIKey, 0, len(keys))}
+	for i := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toAPIKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, request openapi.RevokeAPIKeyRequestObject) (openapi.RevokeAPIKeyResponseObject, error) {
+	id := request.Body.Id
+	if err := s.apiKeys.RevokeKey(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("Failed to revoke API key", zap.Error(err), zap.Int("id", id))
+		return nil, serviceError(err, "INTERNAL", "API key not found or already revoked")
+	}
+
+	logger.FromContext(ctx).Info("API key revoked", zap.Int("id", id))
+	return openapi.RevokeAPIKey200JSONResponse{Id: id, Revoked: true}, nil
+}
@@ -0,0 +1,172 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// ExportData - JSON - всё сразу; CSV - одна сущность: ?format=csv&entity=teams|users|members|prs|assignments
+func (s *Server) ExportData(ctx context.Context, request openapi.ExportDataRequestObject) (openapi.ExportDataResponseObject, error) {
+	params := request.Params
+	csv := deref(params.Format) == openapi.ExportDataParamsFormatCsv
+	entity := string(deref(params.Entity))
+	if csv && entity == "" {
+		return nil, fieldError("entity", "is required for CSV")
+	}
+
+	data, err := s.bulk.Export(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export data", zap.Error(err))
+		return nil, newError("INTERNAL", "failed to export data")
+	}
+
+	if csv {
+		var buf bytes.Buffer
+		if err := writeBulkCSV(&buf, data, entity); err != nil {
+			logger.FromContext(ctx).Error("Failed to write CSV export", zap.Error(err))
+			return nil, newError("INTERNAL", "failed to export data")
+		}
+		return openapi.ExportData200TextcsvResponse{
+			Body:          &buf,
+			ContentLength: int64(buf.Len()),
+			Headers:       openapi.ExportData200ResponseHeaders{ContentDisposition: attachment(entity + ".csv")},
+		}, nil
+	}
+	return openapi.ExportData200JSONResponse{
+		Body:    toBulkData(data),
+		Headers: openapi.ExportData200ResponseHeaders{ContentDisposition: attachment("export.json")},
+	}, nil
+}
+
+// ImportData - формат из ?format или Content-Type (text/csv); ?dry_run=true ничего не меняет,
+// возвращает тот же отчёт об изменениях
+func (s *Server) ImportData(ctx context.Context, request openapi.ImportDataRequestObject) (openapi.ImportDataResponseObject, error) {
+	params := request.Params
+	format := string(deref(params.Format))
+	if format == "" {
+		format = "json"
+		if request.Body != nil {
+			format = "csv"
+		}
+	}
+
+	var data *models.BulkData
+	switch {
+	case format == "csv" && request.Body != nil:
+		entity := string(deref(params.Entity))
+		if entity == "" {
+			return nil, fieldError("entity", "is required for CSV")
+		}
+		var err error
+		if data, err = readBulkCSV(request.Body, entity); err != nil {
+			logger.FromContext(ctx).Warn("Failed to decode import body", zap.Error(err), zap.String("format", format))
+			return nil, fieldError("body", err.Error())
+		}
+	case format == "json" && request.JSONBody != nil:
+		data = fromBulkData(request.JSONBody)
+	default:
+		return nil, fieldError("format", fmt.Sprintf("format %s does not match Content-Type", format))
+	}
+
+	dryRun := deref(params.DryRun)
+	result, err := s.bulk.Import(ctx, data, dryRun)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to import data", zap.Error(err))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	logger.FromContext(ctx).Info("Import finished", zap.Bool("dry_run", dryRun), zap.Int("changes", len(result.Changes)))
+	return openapi.ImportData200JSONResponse(toImportResult(result)), nil
+}
+
+func attachment(filename string) string {
+	return fmt.Sprintf("attachment; filename=%q", filename)
+}
+
+func toBulkData(d *models.BulkData) openapi.BulkData {
+	teams := make([]openapi.BulkTeam, 0, len(d.Teams))
+	for _, t := range d.Teams {
+		teams = append(teams, openapi.BulkTeam{TeamName: t.TeamName})
+	}
+	users := make([]openapi.BulkUser, 0, len(d.Users))
+	for _, u := range d.Users {
+		users = append(users, openapi.BulkUser{UserId: u.UserID, Username: u.Username, IsActive: u.IsActive})
+	}
+	members := make([]openapi.BulkMember, 0, len(d.Members))
+	for _, m := range d.Members {
+		members = append(members, openapi.BulkMember{TeamName: m.TeamName, UserId: m.UserID})
+	}
+	prs := make([]openapi.BulkPullRequest, 0, len(d.PullRequests))
+	for _, pr := range d.PullRequests {
+		prs = append(prs, openapi.BulkPullRequest{
+			PullRequestId: pr.ID,
+			Title:         pr.Title,
+			AuthorId:      pr.AuthorID,
+			TeamName:      pr.TeamName,
+			Status:        pr.Status,
+			CreatedAt:     &pr.CreatedAt,
+			MergedAt:      pr.MergedAt,
+		})
+	}
+	assignments := make([]openapi.BulkAssignment, 0, len(d.Assignments))
+	for _, a := range d.Assignments {
+		assignments = append(assignments, openapi.BulkAssignment{PullRequestId: a.PRID, ReviewerId: a.ReviewerID, AssignedAt: &a.AssignedAt})
+	}
+	return openapi.BulkData{Teams: &teams, Users: &users, Members: &members, PullRequests: &prs, Assignments: &assignments}
+}
+
+// fromBulkData - не заданное время (created_at, assigned_at) остаётся нулевым: его выставит импорт
+func fromBulkData(d *openapi.BulkData) *models.BulkData {
+	data := &models.BulkData{}
+	for _, t := range deref(d.Teams) {
+		data.Teams = append(data.Teams, models.BulkTeam{TeamName: t.TeamName})
+	}
+	for _, u := range deref(d.Users) {
+		data.Users = append(data.Users, models.BulkUser{UserID: u.UserId, Username: u.Username, IsActive: u.IsActive})
+	}
+	for _, m := range deref(d.Members) {
+		data.Members = append(data.Members, models.BulkMember{TeamName: m.TeamName, UserID: m.UserId})
+	}
+	for _, pr := range deref(d.PullRequests) {
+		data.PullRequests = append(data.PullRequests, models.BulkPR{
+			ID:        pr.PullRequestId,
+			Title:     pr.Title,
+			AuthorID:  pr.AuthorId,
+			TeamName:  pr.TeamName,
+			Status:    pr.Status,
+			CreatedAt: deref(pr.CreatedAt),
+			MergedAt:  pr.MergedAt,
+		})
+	}
+	for _, a := range deref(d.Assignments) {
+		data.Assignments = append(data.Assignments, models.BulkAssignment{PRID: a.PullRequestId, ReviewerID: a.ReviewerId, AssignedAt: deref(a.AssignedAt)})
+	}
+	return data
+}
+
+func toImportResult(r *models.ImportResult) openapi.ImportResult {
+	out := openapi.ImportResult{
+		DryRun:  r.DryRun,
+		Summary: make(map[string]openapi.ImportCounts, len(r.Summary)),
+		Changes: make([]openapi.ImportChange, 0, len(r.Changes)),
+	}
+	for entity, c := range r.Summary {
+		out.Summary[entity] = openapi.ImportCounts{Created: c.Created, Updated: c.Updated, Unchanged: c.Unchanged}
+	}
+	for _, c := range r.Changes {
+		out.Changes = append(out.Changes, openapi.ImportChange{
+			Entity: c.Entity,
+			Key:    c.Key,
+			Action: openapi.ImportChangeAction(c.Action),
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	return out
+}
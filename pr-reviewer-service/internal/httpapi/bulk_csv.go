@@ -0,0 +1,182 @@
+package httpapi
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"pr-reviewer-service/internal/models"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Колонки CSV по сущностям; CSV всегда про одну сущность (?entity=...)
+var bulkCSVColumns = map[string][]string{
+	"teams":       {"team_name"},
+	"users":       {"user_id", "username", "is_active"},
+	"members":     {"team_name", "user_id"},
+	"prs":         {"pull_request_id", "title", "author_id", "team_name", "status", "created_at", "merged_at"},
+	"assignments": {"pull_request_id", "reviewer_id", "assigned_at"},
+}
+
+// Необязательные колонки: пусто - значение по умолчанию
+var bulkCSVOptional = map[string]bool{"created_at": true, "merged_at": true, "assigned_at": true, "status": true}
+
+func writeBulkCSV(w io.Writer, data *models.BulkData, entity string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(bulkCSVColumns[entity]); err != nil {
+		return err
+	}
+
+	switch entity {
+	case "teams":
+		for _, t := range data.Teams {
+			cw.Write([]string{t.TeamName})
+		}
+	case "users":
+		for _, u := range data.Users {
+			cw.Write([]string{strconv.Itoa(u.UserID), u.Username, strconv.FormatBool(u.IsActive)})
+		}
+	case "members":
+		for _, m := range data.Members {
+			cw.Write([]string{m.TeamName, strconv.Itoa(m.UserID)})
+		}
+	case "prs":
+		for _, pr := range data.PullRequests {
+			var mergedAt string
+			if pr.MergedAt != nil {
+				mergedAt = pr.MergedAt.UTC().Format(time.RFC3339Nano)
+			}
+			cw.Write([]string{strconv.Itoa(pr.ID), pr.Title, strconv.Itoa(pr.AuthorID), pr.TeamName, pr.Status,
+				pr.CreatedAt.UTC().Format(time.RFC3339Nano), mergedAt})
+		}
+	case "assignments":
+		for _, a := range data.Assignments {
+			cw.Write([]string{strconv.Itoa(a.PRID), strconv.Itoa(a.ReviewerID), a.AssignedAt.UTC().Format(time.RFC3339Nano)})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// readBulkCSV - первая строка заголовок; порядок колонок любой, лишние колонки - ошибка
+func readBulkCSV(r io.Reader, entity string) (*models.BulkData, error) {
+	columns, ok := bulkCSVColumns[entity]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity %q", entity)
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty CSV")
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := map[string]int{}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(columns, name) {
+			return nil, fmt.Errorf("unknown column %q for %s", name, entity)
+		}
+		index[name] = i
+	}
+	for _, name := range columns {
+		if _, ok := index[name]; !ok && !bulkCSVOptional[name] {
+			return nil, fmt.Errorf("missing column %q for %s", name, entity)
+		}
+	}
+
+	data := &models.BulkData{}
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := csvRow{record: record, index: index}
+		if err := appendBulkRow(data, entity, &row); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func appendBulkRow(data *models.BulkData, entity string, row *csvRow) error {
+	switch entity {
+	case "teams":
+		data.Teams = append(data.Teams, models.BulkTeam{TeamName: row.get("team_name")})
+	case "users":
+		u := models.BulkUser{UserID: row.id("user_id", "u"), Username: row.get("username"), IsActive: row.bool("is_active")}
+		data.Users = append(data.Users, u)
+	case "members":
+		data.Members = append(data.Members, models.BulkMember{TeamName: row.get("team_name"), UserID: row.id("user_id", "u")})
+	case "prs":
+		pr := models.BulkPR{
+			ID:       row.id("pull_request_id", "pr-"),
+			Title:    row.get("title"),
+			AuthorID: row.id("author_id", "u"),
+			TeamName: row.get("team_name"),
+			Status:   row.get("status"),
+			MergedAt: row.time("merged_at"),
+		}
+		if t := row.time("created_at"); t != nil {
+			pr.CreatedAt = *t
+		}
+		data.PullRequests = append(data.PullRequests, pr)
+	case "assignments":
+		a := models.BulkAssignment{PRID: row.id("pull_request_id", "pr-"), ReviewerID: row.id("reviewer_id", "u")}
+		if t := row.time("assigned_at"); t != nil {
+			a.AssignedAt = *t
+		}
+		data.Assignments = append(data.Assignments, a)
+	}
+	return row.err
+}
+
+// csvRow запоминает первую ошибку разбора, чтобы не проверять каждое поле отдельно
+type csvRow struct {
+	record []string
+	index  map[string]int
+	err    error
+}
+
+func (r *csvRow) get(name string) string {
+	i, ok := r.index[name]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r *csvRow) id(name, prefix string) int {
+	id, err := parseIDValue(r.get(name), prefix)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return id
+}
+
+func (r *csvRow) bool(name string) bool {
+	b, err := strconv.ParseBool(r.get(name))
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("%s: invalid boolean %q", name, r.get(name))
+	}
+	return b
+}
+
+func (r *csvRow) time(name string) *time.Time {
+	v := r.get(name)
+	if v == "" {
+		return nil
+	}
+	t, err := parseTimeValue(v)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return &t
+}
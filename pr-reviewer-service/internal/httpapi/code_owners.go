@@ -0,0 +1,117 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) AddOwnershipRule(ctx context.Context, request openapi.AddOwnershipRuleRequestObject) (openapi.AddOwnershipRuleResponseObject, error) {
+	body := request.Body
+	ids, err := parseUserIDs("user_ids", deref(body.UserIds))
+	if err != nil {
+		return nil, err
+	}
+	rule := &models.OwnershipRule{
+		TeamName:   body.TeamName,
+		Pattern:    body.Pattern,
+		UserIDs:    ids,
+		OwnerTeams: deref(body.OwnerTeams),
+	}
+	if err := s.codeOwners.AddRule(ctx, rule); err != nil {
+		logger.FromContext(ctx).Error("Failed to add ownership rule", zap.Error(err), zap.String("team_name", rule.TeamName))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", rule.TeamName))
+	}
+
+	logger.FromContext(ctx).Info("Ownership rule added", zap.String("team_name", rule.TeamName), zap.String("pattern", rule.Pattern))
+	return openapi.AddOwnershipRule201JSONResponse{Rule: toOwnershipRule(rule)}, nil
+}
+
+func (s *Server) ListOwnershipRules(ctx context.Context, request openapi.ListOwnershipRulesRequestObject) (openapi.ListOwnershipRulesResponseObject, error) {
+	name := request.Params.TeamName
+	rules, err := s.codeOwners.ListRules(ctx, name)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list ownership rules", zap.Error(err), zap.String("team_name", name))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", name))
+	}
+
+	resp := openapi.ListOwnershipRules200JSONResponse{TeamName: name, Rules: make([]openapi.OwnershipRule, 0, len(rules))}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, toOwnershipRule(&rules[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteOwnershipRule(ctx context.Context, request openapi.DeleteOwnershipRuleRequestObject) (openapi.DeleteOwnershipRuleResponseObject, error) {
+	id := request.Body.Id
+	if err := s.codeOwners.DeleteRule(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("Failed to delete ownership rule", zap.Error(err), zap.Int("rule_id", id))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("ownership rule with id %d not found", id))
+	}
+
+	logger.FromContext(ctx).Info("Ownership rule deleted", zap.Int("rule_id", id))
+	return openapi.DeleteOwnershipRule200JSONResponse{Deleted: id}, nil
+}
+
+// ImportCodeowners - тело запроса - файл CODEOWNERS как есть (text/plain), команда - в query.
+// Правила команды заменяются целиком, поэтому слишком большой файл отклоняется, а не обрезается
+func (s *Server) ImportCodeowners(ctx context.Context, request openapi.ImportCodeownersRequestObject) (openapi.ImportCodeownersResponseObject, error) {
+	name := request.Params.TeamName
+	report, err := s.codeOwners.ImportCodeowners(ctx, name, deref(request.Body))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to import CODEOWNERS", zap.Error(err), zap.String("team_name", name))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", name))
+	}
+
+	logger.FromContext(ctx).Info("CODEOWNERS imported", zap.String("team_name", name), zap.Int("rules", report.ImportedRules),
+		zap.Int("problems", len(report.Problems)))
+	return openapi.ImportCodeowners200JSONResponse{Import: toCodeownersReport(report)}, nil
+}
+
+func (s *Server) AddHandleMapping(ctx context.Context, request openapi.AddHandleMappingRequestObject) (openapi.AddHandleMappingResponseObject, error) {
+	body := request.Body
+	m := &models.HandleMapping{Handle: body.Handle, TeamName: deref(body.TeamName)}
+	if body.UserId != nil {
+		id, err := parseID("user_id", *body.UserId, "u")
+		if err != nil {
+			return nil, err
+		}
+		m.UserID = id
+	}
+	if err := s.codeOwners.AddHandleMapping(ctx, m); err != nil {
+		logger.FromContext(ctx).Error("Failed to add handle mapping", zap.Error(err), zap.String("handle", m.Handle))
+		return nil, serviceError(err, "INTERNAL", "user or team not found")
+	}
+
+	logger.FromContext(ctx).Info("Handle mapping added", zap.String("handle", m.Handle))
+	return openapi.AddHandleMapping201JSONResponse{Mapping: toHandleMapping(m)}, nil
+}
+
+func (s *Server) ListHandleMappings(ctx context.Context, request openapi.ListHandleMappingsRequestObject) (openapi.ListHandleMappingsResponseObject, error) {
+	mappings, err := s.codeOwners.ListHandleMappings(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list handle mappings", zap.Error(err))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	resp := openapi.ListHandleMappings200JSONResponse{Mappings: make([]openapi.HandleMapping, 0, len(mappings))}
+	for i := range mappings {
+		resp.Mappings = append(resp.Mappings, toHandleMapping(&mappings[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteHandleMapping(ctx context.Context, request openapi.DeleteHandleMappingRequestObject) (openapi.DeleteHandleMappingResponseObject, error) {
+	handle := request.Body.Handle
+	if err := s.codeOwners.DeleteHandleMapping(ctx, handle); err != nil {
+		logger.FromContext(ctx).Error("Failed to delete handle mapping", zap.Error(err), zap.String("handle", handle))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("handle mapping '%s' not found", handle))
+	}
+
+	logger.FromContext(ctx).Info("Handle mapping deleted", zap.String("handle", handle))
+	return openapi.DeleteHandleMapping200JSONResponse{Deleted: handle}, nil
+}
@@ -0,0 +1,251 @@
+package httpapi
+
+import (
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseID - ID в формате API ("u3", "pr-3") или числом; формат уже проверен по схеме
+func parseID(field, value, prefix string) (int, error) {
+	id, err := parseIDValue(value, prefix)
+	if err != nil {
+		return 0, fieldError(field, err.Error())
+	}
+	return id, nil
+}
+
+func parseIDValue(value, prefix string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(value, prefix))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id %q", value)
+	}
+	return id, nil
+}
+
+// parseTime принимает RFC3339 или дату YYYY-MM-DD (начало дня UTC); nil - без фильтра
+func parseTime(field string, value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, err := parseTimeValue(*value)
+	if err != nil {
+		return nil, fieldError(field, err.Error())
+	}
+	return &t, nil
+}
+
+func parseTimeValue(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected RFC3339 or YYYY-MM-DD", value)
+	}
+	return t, nil
+}
+
+func userID(id int) string { return fmt.Sprintf("u%d", id) }
+
+// parseUserIDs - список ID пользователей; в ошибке - позиция в списке ("user_ids[1]")
+func parseUserIDs(field string, values []string) ([]int, error) {
+	ids := make([]int, 0, len(values))
+	for i, value := range values {
+		id, err := parseID(fmt.Sprintf("%s[%d]", field, i), value, "u")
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func userIDs(ids []int) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, userID(id))
+	}
+	return out
+}
+
+func prID(id int) string { return fmt.Sprintf("pr-%d", id) }
+
+func deref[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+// timestamp - время в UTC с точностью до секунды, как в остальных ответах API; nil для нулевого
+func timestamp(t *time.Time) *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	ts := t.UTC().Truncate(time.Second)
+	return &ts
+}
+
+// listFilter - общие для /pullRequest/list и /users/getReview параметры страницы
+func listFilter(status *openapi.PullRequestStatus, sort string, limit *int, cursor *string) models.PRListFilter {
+	f := models.PRListFilter{
+		Status: string(deref(status)),
+		Limit:  deref(limit),
+		Cursor: deref(cursor),
+	}
+	if sort != "" {
+		f.Desc = strings.HasPrefix(sort, "-")
+		f.Sort = strings.TrimPrefix(sort, "-")
+	}
+	return f
+}
+
+func toTeam(t *models.Team) openapi.Team {
+	team := openapi.Team{TeamName: t.TeamName, Members: make([]openapi.TeamMember, 0, len(t.Members))}
+	for _, m := range t.Members {
+		team.Members = append(team.Members, openapi.TeamMember{UserId: userID(m.UserID), Username: m.Username, IsActive: m.IsActive})
+	}
+	return team
+}
+
+func fromTeam(t *openapi.Team) (*models.Team, error) {
+	team := &models.Team{TeamName: t.TeamName, Members: make([]models.TeamMember, 0, len(t.Members))}
+	for _, m := range t.Members {
+		id, err := parseID("user_id", m.UserId, "u")
+		if err != nil {
+			return nil, err
+		}
+		team.Members = append(team.Members, models.TeamMember{UserID: id, Username: m.Username, IsActive: m.IsActive})
+	}
+	return team, nil
+}
+
+func toUser(u *models.User) openapi.User {
+	return openapi.User{UserId: userID(u.ID), Username: u.Username, TeamName: u.TeamName, IsActive: u.IsActive}
+}
+
+func toPullRequest(pr *models.PullRequest) openapi.PullRequest {
+	out := openapi.PullRequest{
+		PullRequestId:     prID(pr.ID),
+		PullRequestName:   pr.Title,
+		AuthorId:          userID(pr.AuthorID),
+		Status:            openapi.PullRequestStatus(pr.Status),
+		AssignedReviewers: make([]openapi.UserId, 0, len(pr.AssignedReviewers)),
+		CreatedAt:         timestamp(&pr.CreatedAt),
+		MergedAt:          timestamp(pr.MergedAt),
+	}
+	for _, r := range pr.AssignedReviewers {
+		out.AssignedReviewers = append(out.AssignedReviewers, userID(r))
+	}
+	if pr.Understaffed {
+		out.Understaffed = &pr.Understaffed
+	}
+	return out
+}
+
+func toPullRequests(prs []models.PullRequest) []openapi.PullRequest {
+	out := make([]openapi.PullRequest, 0, len(prs))
+	for i := range prs {
+		out = append(out, toPullRequest(&prs[i]))
+	}
+	return out
+}
+
+func toPullRequestDetails(d *models.PRDetails) openapi.PullRequestDetails {
+	pr := toPullRequest(&d.PullRequest)
+	out := openapi.PullRequestDetails{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		Understaffed:      pr.Understaffed,
+		TeamName:          d.TeamName,
+		Reviewers:         make([]openapi.Reviewer, 0, len(d.Reviewers)),
+		History:           make([]openapi.AssignmentEvent, 0, len(d.History)),
+	}
+	for _, r := range d.Reviewers {
+		out.Reviewers = append(out.Reviewers, openapi.Reviewer{UserId: userID(r.UserID), Username: r.Username, AssignedAt: r.AssignedAt})
+	}
+	for _, e := range d.History {
+		out.History = append(out.History, openapi.AssignmentEvent{
+			ReviewerId: userID(e.ReviewerID),
+			Username:   e.Username,
+			Action:     openapi.AssignmentEventAction(e.Action),
+			Reason:     openapi.AssignmentEventReason(e.Reason),
+			At:         e.At,
+		})
+	}
+	return out
+}
+
+func toTeamSLA(sla *models.TeamSLA) openapi.TeamSLA {
+	policy := openapi.TeamSLAPolicy(sla.Policy)
+	return openapi.TeamSLA{TeamName: sla.TeamName, FirstResponseHours: sla.FirstResponseHours, Policy: &policy}
+}
+
+func toOwnershipRule(r *models.OwnershipRule) openapi.OwnershipRule {
+	out := openapi.OwnershipRule{
+		Id:         r.ID,
+		TeamName:   r.TeamName,
+		Position:   r.Position,
+		Pattern:    r.Pattern,
+		UserIds:    userIDs(r.UserIDs),
+		OwnerTeams: r.OwnerTeams,
+	}
+	if out.OwnerTeams == nil {
+		out.OwnerTeams = []string{}
+	}
+	return out
+}
+
+func toHandleMapping(m *models.HandleMapping) openapi.HandleMapping {
+	out := openapi.HandleMapping{Handle: m.Handle}
+	if m.UserID != 0 {
+		id := userID(m.UserID)
+		out.UserId = &id
+	}
+	if m.TeamName != "" {
+		out.TeamName = &m.TeamName
+	}
+	return out
+}
+
+func toCodeownersReport(r *models.CodeownersImportReport) openapi.CodeownersImportReport {
+	out := openapi.CodeownersImportReport{
+		TeamName:       r.TeamName,
+		ImportedRules:  r.ImportedRules,
+		UnknownHandles: r.UnknownHandles,
+		Problems:       make([]openapi.ImportLineError, 0, len(r.Problems)),
+	}
+	if out.UnknownHandles == nil {
+		out.UnknownHandles = []string{}
+	}
+	for _, p := range r.Problems {
+		out.Problems = append(out.Problems, openapi.ImportLineError{Line: p.Line, Message: p.Message})
+	}
+	return out
+}
+
+func toUnavailability(u *models.Unavailability) openapi.Unavailability {
+	out := openapi.Unavailability{Id: u.ID, UserId: userID(u.UserID), StartsAt: u.StartsAt, EndsAt: u.EndsAt}
+	if u.Reason != "" {
+		out.Reason = &u.Reason
+	}
+	return out
+}
+
+func toWorkSchedule(s *models.WorkSchedule) openapi.WorkSchedule {
+	return openapi.WorkSchedule{UserId: userID(s.UserID), TimeZone: s.TimeZone, WorkStart: s.WorkStart, WorkEnd: s.WorkEnd, WorkDays: s.WorkDays}
+}
+
+func toAPIKey(k *models.APIKey) openapi.APIKey {
+	return openapi.APIKey{Id: k.ID, Name: k.Name, Role: openapi.APIKeyRole(k.Role), CreatedAt: k.CreatedAt, RevokedAt: k.RevokedAt}
+}
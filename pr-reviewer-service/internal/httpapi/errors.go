@@ -0,0 +1,194 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Коды ошибок сервисов и их HTTP-статусы
+var errorStatuses = map[string]int{
+	"BAD_REQUEST":       http.StatusBadRequest,
+	"PAYLOAD_TOO_LARGE": http.StatusRequestEntityTooLarge,
+	"NOT_FOUND":         http.StatusNotFound,
+	"TEAM_EXISTS":       http.StatusBadRequest,
+	"PR_EXISTS":         http.StatusConflict,
+	"PR_MERGED":         http.StatusConflict,
+	"NOT_ASSIGNED":      http.StatusConflict,
+	"NO_CANDIDATE":      http.StatusConflict,
+	"UNAUTHORIZED":      http.StatusUnauthorized,
+	"FORBIDDEN":         http.StatusForbidden,
+	"INTERNAL":          http.StatusInternalServerError,
+}
+
+// apiError - ошибка операции; отдаётся как ErrorResponse с кодом code
+type apiError struct {
+	code    string
+	message string
+	details []openapi.FieldError
+}
+
+func (e *apiError) Error() string { return e.code + ": " + e.message }
+
+func newError(code, message string) error {
+	return &apiError{code: code, message: message}
+}
+
+// validationError - BAD_REQUEST с проблемами по полям
+func validationError(v *models.ValidationError) *apiError {
+	details := make([]openapi.FieldError, 0, len(v.Fields))
+	for _, f := range v.Fields {
+		details = append(details, openapi.FieldError{Field: f.Field, Message: f.Message})
+	}
+	return &apiError{code: "BAD_REQUEST", message: v.Message(), details: details}
+}
+
+// fieldError - BAD_REQUEST с одной проблемой в поле field
+func fieldError(field, message string) error {
+	v := &models.ValidationError{}
+	v.Add(field, message)
+	return validationError(v)
+}
+
+// serviceError переводит ошибку сервиса в ответ: *models.ValidationError - BAD_REQUEST с
+// полями, "not found" - NOT_FOUND с сообщением notFound, "not found: <что>" - NOT_FOUND
+// про то, чего нет, "CODE: ..." - этот код, остальное - fallback (как в gRPC API)
+func serviceError(err error, fallback, notFound string) error {
+	var v *models.ValidationError
+	if errors.As(err, &v) {
+		return validationError(v)
+	}
+	msg := err.Error()
+	if msg == "not found" {
+		return newError("NOT_FOUND", notFound)
+	}
+	if what, ok := strings.CutPrefix(msg, "not found: "); ok {
+		return newError("NOT_FOUND", what+" not found")
+	}
+	if code, _, ok := strings.Cut(msg, ": "); ok {
+		if _, known := errorStatuses[code]; known {
+			return newError(code, msg)
+		}
+	}
+	return newError(fallback, msg)
+}
+
+func writeError(w http.ResponseWriter, code, message string) {
+	writeAPIError(w, &apiError{code: code, message: message})
+}
+
+func writeAPIError(w http.ResponseWriter, e *apiError) {
+	status, ok := errorStatuses[e.code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	detail := openapi.ErrorDetail{Code: openapi.ErrorDetailCode(e.code), Message: e.message}
+	if len(e.details) > 0 {
+		detail.Details = &e.details
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: detail})
+}
+
+// scimError - ошибка операций /scim/v2: IdP ждут её в формате SCIM (RFC 7644, 3.12),
+// а не ErrorResponse
+type scimError struct {
+	status   int
+	scimType openapi.ScimErrorScimType
+	detail   string
+}
+
+func (e *scimError) Error() string { return e.detail }
+
+// isSCIM - запрос к SCIM API: ошибки проверки и разбора тоже отдаются в формате SCIM
+func isSCIM(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/scim/")
+}
+
+// bodyTooLarge - сообщение, если тело запроса упёрлось в предел http.MaxBytesReader
+func bodyTooLarge(err error) (string, bool) {
+	var sizeErr *http.MaxBytesError
+	if !errors.As(err, &sizeErr) {
+		return "", false
+	}
+	return fmt.Sprintf("request body must not exceed %d bytes", sizeErr.Limit), true
+}
+
+// requestError - запрос не прошёл проверку или разбор: 413 PAYLOAD_TOO_LARGE для слишком
+// большого тела, иначе BAD_REQUEST с полями
+func requestError(err error, fields *models.ValidationError) *apiError {
+	if msg, ok := bodyTooLarge(err); ok {
+		return &apiError{code: "PAYLOAD_TOO_LARGE", message: msg}
+	}
+	return validationError(fields)
+}
+
+// scimRequestError - запрос не прошёл проверку: 413 для слишком большого тела, иначе 400 invalidSyntax
+func scimRequestError(err error, detail string) *scimError {
+	if msg, ok := bodyTooLarge(err); ok {
+		return &scimError{status: http.StatusRequestEntityTooLarge, detail: msg}
+	}
+	return &scimError{status: http.StatusBadRequest, scimType: openapi.InvalidSyntax, detail: detail}
+}
+
+func writeSCIMError(w http.ResponseWriter, e *scimError) {
+	body := openapi.ScimError{
+		Schemas: []string{models.ScimErrorSchema},
+		Status:  strconv.Itoa(e.status),
+		Detail:  e.detail,
+	}
+	if e.scimType != "" {
+		body.ScimType = &e.scimType
+	}
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(e.status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// requestErrorHandler - тело или параметры не разобрались в сгенерированные типы
+func requestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	logger.FromContext(r.Context()).Warn("Failed to decode API request", zap.Error(err), zap.String("path", r.URL.Path))
+	if isSCIM(r) {
+		writeSCIMError(w, scimRequestError(err, err.Error()))
+		return
+	}
+	if msg, ok := bodyTooLarge(err); ok {
+		writeError(w, "PAYLOAD_TOO_LARGE", msg)
+		return
+	}
+	writeError(w, "BAD_REQUEST", err.Error())
+}
+
+// responseErrorHandler - операция вернула ошибку вместо ответа
+func responseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		writeAPIError(w, apiErr)
+		return
+	}
+	var scimErr *scimError
+	if errors.As(err, &scimErr) {
+		writeSCIMError(w, scimErr)
+		return
+	}
+	logger.FromContext(r.Context()).Error("Failed to write API response", zap.Error(err), zap.String("path", r.URL.Path))
+	writeInternalError(w, r, "internal error")
+}
+
+// writeInternalError - 500 в формате операции (SCIM или ErrorResponse)
+func writeInternalError(w http.ResponseWriter, r *http.Request, message string) {
+	if isSCIM(r) {
+		writeSCIMError(w, &scimError{status: http.StatusInternalServerError, detail: message})
+		return
+	}
+	writeError(w, "INTERNAL", message)
+}
@@ -0,0 +1,52 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// GitlabWebhook - вебхук merge request. API-ключ не нужен: GitLab подтверждает
+// себя секретом из настроек вебхука (X-Gitlab-Token)
+func (s *Server) GitlabWebhook(ctx context.Context, request openapi.GitlabWebhookRequestObject) (openapi.GitlabWebhookResponseObject, error) {
+	if s.gitlabSecret == "" {
+		return nil, newError("NOT_FOUND", "GitLab integration is disabled")
+	}
+	params := request.Params
+	if subtle.ConstantTimeCompare([]byte(deref(params.XGitlabToken)), []byte(s.gitlabSecret)) != 1 {
+		logger.FromContext(ctx).Warn("GitLab webhook with invalid token")
+		return nil, newError("UNAUTHORIZED", "invalid X-Gitlab-Token")
+	}
+
+	// Прочие события (push, note, ...) GitLab шлёт, если они включены в настройках вебхука
+	if event := deref(params.XGitlabEvent); event != gitlab.MergeRequestEventHeader {
+		return webhookIgnored(fmt.Sprintf("event %q", event)), nil
+	}
+
+	ev := request.Body
+	status, id, err := s.gitlab.HandleMergeRequest(ctx, ev)
+	if err != nil {
+		// Ответ не 2xx GitLab считает сбоем и повторяет, поэтому неинтересные события - 200
+		if reason, ok := strings.CutPrefix(err.Error(), "IGNORED: "); ok {
+			logger.FromContext(ctx).Info("GitLab merge request event ignored", zap.Error(err),
+				zap.Int64("project_id", ev.Project.ID), zap.Int("mr_iid", ev.ObjectAttributes.IID))
+			return webhookIgnored(reason), nil
+		}
+		logger.FromContext(ctx).Error("Failed to handle GitLab merge request event", zap.Error(err),
+			zap.Int64("project_id", ev.Project.ID), zap.Int("mr_iid", ev.ObjectAttributes.IID))
+		return nil, newError("INTERNAL", "failed to handle merge request event")
+	}
+
+	pr := prID(id)
+	return openapi.GitlabWebhook200JSONResponse{Status: openapi.WebhookResultStatus(status), PullRequestId: &pr}, nil
+}
+
+func webhookIgnored(reason string) openapi.GitlabWebhook200JSONResponse {
+	return openapi.GitlabWebhook200JSONResponse{Status: openapi.Ignored, Reason: &reason}
+}
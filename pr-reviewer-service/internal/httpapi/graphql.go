@@ -0,0 +1,67 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+)
+
+func (s *Server) ExecuteGraphQL(ctx context.Context, request openapi.ExecuteGraphQLRequestObject) (openapi.ExecuteGraphQLResponseObject, error) {
+	body := request.Body
+	return openapi.ExecuteGraphQL200JSONResponse(s.executeGraphQL(ctx, body.Query, deref(body.OperationName), deref(body.Variables))), nil
+}
+
+// ExecuteGraphQLGet - для ссылок и кэширующих прокси: ?query=...&operationName=...&variables={...}
+func (s *Server) ExecuteGraphQLGet(ctx context.Context, request openapi.ExecuteGraphQLGetRequestObject) (openapi.ExecuteGraphQLGetResponseObject, error) {
+	params := request.Params
+	var variables map[string]interface{}
+	if v := deref(params.Variables); v != "" {
+		if err := json.Unmarshal([]byte(v), &variables); err != nil {
+			return nil, fieldError("variables", err.Error())
+		}
+	}
+	return openapi.ExecuteGraphQLGet200JSONResponse(s.executeGraphQL(ctx, params.Query, deref(params.OperationName), variables)), nil
+}
+
+// executeGraphQL - ошибки разбора, проверки и выполнения запроса GraphQL возвращает в errors с кодом 200
+func (s *Server) executeGraphQL(ctx context.Context, query, operationName string, variables map[string]interface{}) openapi.GraphQLResponse {
+	result := s.graphql.Execute(ctx, query, operationName, variables)
+	if result.HasErrors() {
+		logger.FromContext(ctx).Warn("GraphQL query failed", zap.String("operation", operationName),
+			zap.String("error", result.Errors[0].Message), zap.Int("errors", len(result.Errors)))
+	} else {
+		logger.FromContext(ctx).Info("GraphQL query executed", zap.String("operation", operationName))
+	}
+	return toGraphQLResponse(result)
+}
+
+func toGraphQLResponse(result *graphql.Result) openapi.GraphQLResponse {
+	resp := openapi.GraphQLResponse{Data: result.Data}
+	if !result.HasErrors() {
+		return resp
+	}
+	errs := make([]openapi.GraphQLError, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		out := openapi.GraphQLError{Message: e.Message}
+		if len(e.Locations) > 0 {
+			locations := make([]openapi.GraphQLLocation, 0, len(e.Locations))
+			for _, l := range e.Locations {
+				locations = append(locations, openapi.GraphQLLocation{Line: l.Line, Column: l.Column})
+			}
+			out.Locations = &locations
+		}
+		if len(e.Path) > 0 {
+			out.Path = &e.Path
+		}
+		if len(e.Extensions) > 0 {
+			out.Extensions = &e.Extensions
+		}
+		errs = append(errs, out)
+	}
+	resp.Errors = &errs
+	return resp
+}
@@ -0,0 +1,33 @@
+package httpapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// HealthLive - процесс жив и обслуживает HTTP; зависимости не проверяются,
+// чтобы недоступная БД не приводила к перезапуску пода
+func (s *Server) HealthLive(ctx context.Context, request openapi.HealthLiveRequestObject) (openapi.HealthLiveResponseObject, error) {
+	return openapi.HealthLive200JSONResponse{Status: "ok"}, nil
+}
+
+func (s *Server) HealthReady(ctx context.Context, request openapi.HealthReadyRequestObject) (openapi.HealthReadyResponseObject, error) {
+	ready, checks := s.health.Ready(ctx)
+	resp := openapi.ReadinessResponse{Status: openapi.Ok, Checks: make(map[string]openapi.HealthCheck, len(checks))}
+	for name, c := range checks {
+		check := openapi.HealthCheck{Ok: c.OK, Details: c.Details}
+		if c.Message != "" {
+			check.Message = &c.Message
+		}
+		resp.Checks[name] = check
+	}
+	if !ready {
+		logger.FromContext(ctx).Warn("Readiness check failed", zap.Any("checks", checks))
+		resp.Status = openapi.Unavailable
+		return openapi.HealthReady503JSONResponse(resp), nil
+	}
+	return openapi.HealthReady200JSONResponse(resp), nil
+}
@@ -0,0 +1,16 @@
+package httpapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/metrics"
+)
+
+// GetMetrics - метрики для Prometheus; доступны без ключа (security: [])
+func (s *Server) GetMetrics(ctx context.Context, request openapi.GetMetricsRequestObject) (openapi.GetMetricsResponseObject, error) {
+	text, err := metrics.Text()
+	if err != nil {
+		return nil, err
+	}
+	return openapi.GetMetrics200TextResponse(text), nil
+}
@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// SetTeamNotificationWebhook - пустой url отключает уведомления команды
+func (s *Server) SetTeamNotificationWebhook(ctx context.Context, request openapi.SetTeamNotificationWebhookRequestObject) (openapi.SetTeamNotificationWebhookResponseObject, error) {
+	body := request.Body
+	if err := s.notifications.SetTeamWebhook(ctx, body.TeamName, body.Url); err != nil {
+		logger.FromContext(ctx).Error("Failed to set notification webhook", zap.Error(err), zap.String("team_name", body.TeamName))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", body.TeamName))
+	}
+
+	// URL вебхука содержит секрет - в лог не пишем
+	logger.FromContext(ctx).Info("Team notification webhook updated", zap.String("team_name", body.TeamName), zap.Bool("enabled", body.Url != ""))
+	return openapi.SetTeamNotificationWebhook200JSONResponse{TeamName: body.TeamName, Enabled: body.Url != ""}, nil
+}
+
+func (s *Server) SetUserNotifications(ctx context.Context, request openapi.SetUserNotificationsRequestObject) (openapi.SetUserNotificationsResponseObject, error) {
+	body := request.Body
+	id, err := parseID("user_id", body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	// Ревьювер может отписаться (или подписаться) только сам
+	if p := middleware.PrincipalFrom(ctx); p != nil && p.Role == models.RoleReviewer && p.UserID != id {
+		return nil, newError("FORBIDDEN", "reviewers can change only their own notification settings")
+	}
+	if err := s.notifications.SetUserNotifications(ctx, id, body.Enabled); err != nil {
+		logger.FromContext(ctx).Error("Failed to update notification settings", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("User notification settings updated", zap.Int("user_id", id), zap.Bool("enabled", body.Enabled))
+	return openapi.SetUserNotifications200JSONResponse{UserId: userID(id), NotificationsEnabled: body.Enabled}, nil
+}
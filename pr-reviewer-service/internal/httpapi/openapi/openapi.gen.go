@@ -0,0 +1,9096 @@
+// Package openapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"pr-reviewer-service/internal/gitlab"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for APIKeyRole.
+const (
+	APIKeyRoleAdmin  APIKeyRole = "admin"
+	APIKeyRoleBot    APIKeyRole = "bot"
+	APIKeyRoleReader APIKeyRole = "reader"
+)
+
+// Defines values for AssignmentEventAction.
+const (
+	ASSIGNED   AssignmentEventAction = "ASSIGNED"
+	UNASSIGNED AssignmentEventAction = "UNASSIGNED"
+)
+
+// Defines values for AssignmentEventReason.
+const (
+	ADDED      AssignmentEventReason = "ADDED"
+	BACKFILL   AssignmentEventReason = "BACKFILL"
+	CREATED    AssignmentEventReason = "CREATED"
+	IMPORT     AssignmentEventReason = "IMPORT"
+	REASSIGNED AssignmentEventReason = "REASSIGNED"
+)
+
+// Defines values for ErrorDetailCode.
+const (
+	BADREQUEST      ErrorDetailCode = "BAD_REQUEST"
+	FORBIDDEN       ErrorDetailCode = "FORBIDDEN"
+	INTERNAL        ErrorDetailCode = "INTERNAL"
+	NOCANDIDATE     ErrorDetailCode = "NO_CANDIDATE"
+	NOTASSIGNED     ErrorDetailCode = "NOT_ASSIGNED"
+	NOTFOUND        ErrorDetailCode = "NOT_FOUND"
+	PAYLOADTOOLARGE ErrorDetailCode = "PAYLOAD_TOO_LARGE"
+	PREXISTS        ErrorDetailCode = "PR_EXISTS"
+	PRMERGED        ErrorDetailCode = "PR_MERGED"
+	TEAMEXISTS      ErrorDetailCode = "TEAM_EXISTS"
+	UNAUTHORIZED    ErrorDetailCode = "UNAUTHORIZED"
+)
+
+// Defines values for ImportChangeAction.
+const (
+	CREATE ImportChangeAction = "CREATE"
+	UPDATE ImportChangeAction = "UPDATE"
+)
+
+// Defines values for LogLevelLevel.
+const (
+	LogLevelLevelDebug  LogLevelLevel = "debug"
+	LogLevelLevelDpanic LogLevelLevel = "dpanic"
+	LogLevelLevelError  LogLevelLevel = "error"
+	LogLevelLevelFatal  LogLevelLevel = "fatal"
+	LogLevelLevelInfo   LogLevelLevel = "info"
+	LogLevelLevelPanic  LogLevelLevel = "panic"
+	LogLevelLevelWarn   LogLevelLevel = "warn"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	MERGED PullRequestStatus = "MERGED"
+	OPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for ReadinessResponseStatus.
+const (
+	Ok          ReadinessResponseStatus = "ok"
+	Unavailable ReadinessResponseStatus = "unavailable"
+)
+
+// Defines values for ScimErrorScimType.
+const (
+	InvalidFilter ScimErrorScimType = "invalidFilter"
+	InvalidSyntax ScimErrorScimType = "invalidSyntax"
+	InvalidValue  ScimErrorScimType = "invalidValue"
+	Uniqueness    ScimErrorScimType = "uniqueness"
+)
+
+// Defines values for ScimMetaResourceType.
+const (
+	ScimMetaResourceTypeGroup ScimMetaResourceType = "Group"
+	ScimMetaResourceTypeUser  ScimMetaResourceType = "User"
+)
+
+// Defines values for TeamSLAPolicy.
+const (
+	ADDREVIEWER TeamSLAPolicy = "ADD_REVIEWER"
+	ESCALATE    TeamSLAPolicy = "ESCALATE"
+	REASSIGN    TeamSLAPolicy = "REASSIGN"
+)
+
+// Defines values for WebhookResultStatus.
+const (
+	Created WebhookResultStatus = "created"
+	Exists  WebhookResultStatus = "exists"
+	Ignored WebhookResultStatus = "ignored"
+	Merged  WebhookResultStatus = "merged"
+)
+
+// Defines values for CreateAPIKeyJSONBodyRole.
+const (
+	CreateAPIKeyJSONBodyRoleAdmin  CreateAPIKeyJSONBodyRole = "admin"
+	CreateAPIKeyJSONBodyRoleBot    CreateAPIKeyJSONBodyRole = "bot"
+	CreateAPIKeyJSONBodyRoleReader CreateAPIKeyJSONBodyRole = "reader"
+)
+
+// Defines values for ExportDataParamsFormat.
+const (
+	ExportDataParamsFormatCsv  ExportDataParamsFormat = "csv"
+	ExportDataParamsFormatJson ExportDataParamsFormat = "json"
+)
+
+// Defines values for ExportDataParamsEntity.
+const (
+	ExportDataParamsEntityAssignments ExportDataParamsEntity = "assignments"
+	ExportDataParamsEntityMembers     ExportDataParamsEntity = "members"
+	ExportDataParamsEntityPrs         ExportDataParamsEntity = "prs"
+	ExportDataParamsEntityTeams       ExportDataParamsEntity = "teams"
+	ExportDataParamsEntityUsers       ExportDataParamsEntity = "users"
+)
+
+// Defines values for ImportDataParamsFormat.
+const (
+	ImportDataParamsFormatCsv  ImportDataParamsFormat = "csv"
+	ImportDataParamsFormatJson ImportDataParamsFormat = "json"
+)
+
+// Defines values for ImportDataParamsEntity.
+const (
+	ImportDataParamsEntityAssignments ImportDataParamsEntity = "assignments"
+	ImportDataParamsEntityMembers     ImportDataParamsEntity = "members"
+	ImportDataParamsEntityPrs         ImportDataParamsEntity = "prs"
+	ImportDataParamsEntityTeams       ImportDataParamsEntity = "teams"
+	ImportDataParamsEntityUsers       ImportDataParamsEntity = "users"
+)
+
+// Defines values for ListPullRequestsParamsSort.
+const (
+	ListPullRequestsParamsSortCreatedAt      ListPullRequestsParamsSort = "created_at"
+	ListPullRequestsParamsSortId             ListPullRequestsParamsSort = "id"
+	ListPullRequestsParamsSortMergedAt       ListPullRequestsParamsSort = "merged_at"
+	ListPullRequestsParamsSortMinusCreatedAt ListPullRequestsParamsSort = "-created_at"
+	ListPullRequestsParamsSortMinusId        ListPullRequestsParamsSort = "-id"
+	ListPullRequestsParamsSortMinusMergedAt  ListPullRequestsParamsSort = "-merged_at"
+	ListPullRequestsParamsSortMinusStatus    ListPullRequestsParamsSort = "-status"
+	ListPullRequestsParamsSortMinusTitle     ListPullRequestsParamsSort = "-title"
+	ListPullRequestsParamsSortStatus         ListPullRequestsParamsSort = "status"
+	ListPullRequestsParamsSortTitle          ListPullRequestsParamsSort = "title"
+)
+
+// Defines values for GetUserReviewParamsSort.
+const (
+	GetUserReviewParamsSortCreatedAt      GetUserReviewParamsSort = "created_at"
+	GetUserReviewParamsSortId             GetUserReviewParamsSort = "id"
+	GetUserReviewParamsSortMinusCreatedAt GetUserReviewParamsSort = "-created_at"
+	GetUserReviewParamsSortMinusId        GetUserReviewParamsSort = "-id"
+	GetUserReviewParamsSortMinusStatus    GetUserReviewParamsSort = "-status"
+	GetUserReviewParamsSortMinusTitle     GetUserReviewParamsSort = "-title"
+	GetUserReviewParamsSortStatus         GetUserReviewParamsSort = "status"
+	GetUserReviewParamsSortTitle          GetUserReviewParamsSort = "title"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time  `json:"created_at"`
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Role      APIKeyRole `json:"role"`
+}
+
+// APIKeyRole defines model for APIKey.Role.
+type APIKeyRole string
+
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	Action AssignmentEventAction `json:"action"`
+	At     time.Time             `json:"at"`
+	Reason AssignmentEventReason `json:"reason"`
+
+	// ReviewerId ID пользователя, "u3" или "3"
+	ReviewerId UserId `json:"reviewer_id"`
+	Username   string `json:"username"`
+}
+
+// AssignmentEventAction defines model for AssignmentEvent.Action.
+type AssignmentEventAction string
+
+// AssignmentEventReason defines model for AssignmentEvent.Reason.
+type AssignmentEventReason string
+
+// BulkAssignment defines model for BulkAssignment.
+type BulkAssignment struct {
+	AssignedAt    *time.Time `json:"assigned_at,omitempty"`
+	PullRequestId int        `json:"pull_request_id"`
+	ReviewerId    int        `json:"reviewer_id"`
+}
+
+// BulkData Формат экспорта и импорта; ID - числа
+type BulkData struct {
+	Assignments  *[]BulkAssignment  `json:"assignments,omitempty"`
+	Members      *[]BulkMember      `json:"members,omitempty"`
+	PullRequests *[]BulkPullRequest `json:"pull_requests,omitempty"`
+	Teams        *[]BulkTeam        `json:"teams,omitempty"`
+	Users        *[]BulkUser        `json:"users,omitempty"`
+}
+
+// BulkMember defines model for BulkMember.
+type BulkMember struct {
+	TeamName string `json:"team_name"`
+	UserId   int    `json:"user_id"`
+}
+
+// BulkPullRequest defines model for BulkPullRequest.
+type BulkPullRequest struct {
+	AuthorId      int        `json:"author_id"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	MergedAt      *time.Time `json:"merged_at,omitempty"`
+	PullRequestId int        `json:"pull_request_id"`
+
+	// Status OPEN или MERGED; пусто при импорте - OPEN
+	Status   string `json:"status"`
+	TeamName string `json:"team_name"`
+	Title    string `json:"title"`
+}
+
+// BulkTeam defines model for BulkTeam.
+type BulkTeam struct {
+	TeamName string `json:"team_name"`
+}
+
+// BulkUser defines model for BulkUser.
+type BulkUser struct {
+	IsActive bool   `json:"is_active"`
+	UserId   int    `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// CodeownersImportReport defines model for CodeownersImportReport.
+type CodeownersImportReport struct {
+	ImportedRules  int               `json:"imported_rules"`
+	Problems       []ImportLineError `json:"problems"`
+	TeamName       string            `json:"team_name"`
+	UnknownHandles []string          `json:"unknown_handles"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	Code ErrorDetailCode `json:"code"`
+
+	// Details Для BAD_REQUEST - проблемы по отдельным полям запроса
+	Details *[]FieldError `json:"details,omitempty"`
+	Message string        `json:"message"`
+}
+
+// ErrorDetailCode defines model for ErrorDetail.Code.
+type ErrorDetailCode string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю ("members[1].user_id"), имя параметра или "body"
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// GitLabMergeRequestEvent Событие вебхука GitLab. Описаны только поля, которые читает сервис; остальные
+// (а их GitLab присылает много) допустимы и игнорируются
+type GitLabMergeRequestEvent = gitlab.MergeRequestEvent
+
+// GraphQLError defines model for GraphQLError.
+type GraphQLError struct {
+	// Extensions code - BAD_REQUEST, INTERNAL, QUERY_TOO_COMPLEX, QUERY_TOO_DEEP
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+	Locations  *[]GraphQLLocation      `json:"locations,omitempty"`
+	Message    string                  `json:"message"`
+	Path       *[]interface{}          `json:"path,omitempty"`
+}
+
+// GraphQLLocation defines model for GraphQLLocation.
+type GraphQLLocation struct {
+	Column int `json:"column"`
+	Line   int `json:"line"`
+}
+
+// GraphQLRequest Прочие поля (extensions и т.п.) клиенты GraphQL присылают сами - они допустимы и игнорируются
+type GraphQLRequest struct {
+	OperationName *string                 `json:"operationName"`
+	Query         string                  `json:"query"`
+	Variables     *map[string]interface{} `json:"variables"`
+}
+
+// GraphQLResponse defines model for GraphQLResponse.
+type GraphQLResponse struct {
+	Data   interface{}     `json:"data"`
+	Errors *[]GraphQLError `json:"errors,omitempty"`
+}
+
+// HandleMapping Задаётся либо user_id, либо team_name
+type HandleMapping struct {
+	// Handle @user, @org/team или email из CODEOWNERS
+	Handle   string  `json:"handle"`
+	TeamName *string `json:"team_name,omitempty"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId *UserId `json:"user_id,omitempty"`
+}
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Details interface{} `json:"details,omitempty"`
+	Message *string     `json:"message,omitempty"`
+	Ok      bool        `json:"ok"`
+}
+
+// ImportChange defines model for ImportChange.
+type ImportChange struct {
+	Action ImportChangeAction `json:"action"`
+
+	// After Запись после изменения
+	After interface{} `json:"after"`
+
+	// Before Запись до изменения (для UPDATE)
+	Before interface{} `json:"before,omitempty"`
+	Entity string      `json:"entity"`
+	Key    string      `json:"key"`
+}
+
+// ImportChangeAction defines model for ImportChange.Action.
+type ImportChangeAction string
+
+// ImportCounts defines model for ImportCounts.
+type ImportCounts struct {
+	Created   int `json:"created"`
+	Unchanged int `json:"unchanged"`
+	Updated   int `json:"updated"`
+}
+
+// ImportLineError defines model for ImportLineError.
+type ImportLineError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Changes []ImportChange `json:"changes"`
+	DryRun  bool           `json:"dry_run"`
+
+	// Summary Счётчики по сущностям (teams, users, members, pull_requests, assignments)
+	Summary map[string]ImportCounts `json:"summary"`
+}
+
+// LogLevel defines model for LogLevel.
+type LogLevel struct {
+	Level LogLevelLevel `json:"level"`
+}
+
+// LogLevelLevel defines model for LogLevel.Level.
+type LogLevelLevel string
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	Id         int      `json:"id"`
+	OwnerTeams []string `json:"owner_teams"`
+	Pattern    string   `json:"pattern"`
+
+	// Position Порядок правила; как в CODEOWNERS, побеждает последнее совпавшее
+	Position int      `json:"position"`
+	TeamName string   `json:"team_name"`
+	UserIds  []UserId `json:"user_ids"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2, больше - после SLA ADD_REVIEWER)
+	AssignedReviewers []UserId `json:"assigned_reviewers"`
+
+	// AuthorId ID пользователя, "u3" или "3"
+	AuthorId  UserId     `json:"author_id"`
+	CreatedAt *time.Time `json:"createdAt"`
+	MergedAt  *time.Time `json:"mergedAt"`
+
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId   PullRequestId     `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Status          PullRequestStatus `json:"status"`
+
+	// Understaffed Ревьюверов меньше, чем нужно
+	Understaffed *bool `json:"understaffed,omitempty"`
+}
+
+// PullRequestDetails defines model for PullRequestDetails.
+type PullRequestDetails struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2, больше - после SLA ADD_REVIEWER)
+	AssignedReviewers []UserId `json:"assigned_reviewers"`
+
+	// AuthorId ID пользователя, "u3" или "3"
+	AuthorId  UserId            `json:"author_id"`
+	CreatedAt *time.Time        `json:"createdAt"`
+	History   []AssignmentEvent `json:"history"`
+	MergedAt  *time.Time        `json:"mergedAt"`
+
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId   PullRequestId     `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Reviewers       []Reviewer        `json:"reviewers"`
+	Status          PullRequestStatus `json:"status"`
+	TeamName        string            `json:"team_name"`
+
+	// Understaffed Ревьюверов меньше, чем нужно
+	Understaffed *bool `json:"understaffed,omitempty"`
+}
+
+// PullRequestId ID PR, "pr-1001" или "1001"
+type PullRequestId = string
+
+// PullRequestPage defines model for PullRequestPage.
+type PullRequestPage struct {
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	// AuthorId ID пользователя, "u3" или "3"
+	AuthorId UserId `json:"author_id"`
+
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId   PullRequestId     `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Status          PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequestStatus.
+type PullRequestStatus string
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	Checks map[string]HealthCheck  `json:"checks"`
+	Status ReadinessResponseStatus `json:"status"`
+}
+
+// ReadinessResponseStatus defines model for ReadinessResponse.Status.
+type ReadinessResponseStatus string
+
+// Reviewer defines model for Reviewer.
+type Reviewer struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId   UserId `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ScimAuthenticationScheme defines model for ScimAuthenticationScheme.
+type ScimAuthenticationScheme struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+}
+
+// ScimBulkConfig defines model for ScimBulkConfig.
+type ScimBulkConfig struct {
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+	Supported      bool `json:"supported"`
+}
+
+// ScimError defines model for ScimError.
+type ScimError struct {
+	Detail   string             `json:"detail"`
+	Schemas  []string           `json:"schemas"`
+	ScimType *ScimErrorScimType `json:"scimType,omitempty"`
+
+	// Status HTTP-статус строкой
+	Status string `json:"status"`
+}
+
+// ScimErrorScimType defines model for ScimError.ScimType.
+type ScimErrorScimType string
+
+// ScimFilterConfig defines model for ScimFilterConfig.
+type ScimFilterConfig struct {
+	MaxResults int  `json:"maxResults"`
+	Supported  bool `json:"supported"`
+}
+
+// ScimGroup defines model for ScimGroup.
+type ScimGroup struct {
+	DisplayName string    `json:"displayName"`
+	ExternalId  *string   `json:"externalId,omitempty"`
+	Id          string    `json:"id"`
+	Members     []ScimRef `json:"members"`
+	Meta        ScimMeta  `json:"meta"`
+	Schemas     []string  `json:"schemas"`
+}
+
+// ScimGroupList defines model for ScimGroupList.
+type ScimGroupList struct {
+	Resources    []ScimGroup `json:"Resources"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Schemas      []string    `json:"schemas"`
+	StartIndex   int         `json:"startIndex"`
+	TotalResults int         `json:"totalResults"`
+}
+
+// ScimGroupRequest Тело POST/PUT /Groups; неизвестные атрибуты игнорируются
+type ScimGroupRequest struct {
+	DisplayName *string    `json:"displayName,omitempty"`
+	ExternalId  *string    `json:"externalId,omitempty"`
+	Members     *[]ScimRef `json:"members,omitempty"`
+}
+
+// ScimMeta defines model for ScimMeta.
+type ScimMeta struct {
+	Location     string               `json:"location"`
+	ResourceType ScimMetaResourceType `json:"resourceType"`
+}
+
+// ScimMetaResourceType defines model for ScimMeta.ResourceType.
+type ScimMetaResourceType string
+
+// ScimPatchOp defines model for ScimPatchOp.
+type ScimPatchOp struct {
+	// Op add, replace или remove (регистр не важен)
+	Op    string      `json:"op"`
+	Path  *string     `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ScimPatchRequest defines model for ScimPatchRequest.
+type ScimPatchRequest struct {
+	Operations []ScimPatchOp `json:"Operations"`
+	Schemas    *[]string     `json:"schemas,omitempty"`
+}
+
+// ScimRef defines model for ScimRef.
+type ScimRef struct {
+	Display *string `json:"display,omitempty"`
+
+	// Value ID пользователя или команды
+	Value string `json:"value"`
+}
+
+// ScimServiceProviderConfig defines model for ScimServiceProviderConfig.
+type ScimServiceProviderConfig struct {
+	AuthenticationSchemes []ScimAuthenticationScheme `json:"authenticationSchemes"`
+	Bulk                  ScimBulkConfig             `json:"bulk"`
+	ChangePassword        ScimSupported              `json:"changePassword"`
+	Etag                  ScimSupported              `json:"etag"`
+	Filter                ScimFilterConfig           `json:"filter"`
+	Patch                 ScimSupported              `json:"patch"`
+	Schemas               []string                   `json:"schemas"`
+	Sort                  ScimSupported              `json:"sort"`
+}
+
+// ScimSupported defines model for ScimSupported.
+type ScimSupported struct {
+	Supported bool `json:"supported"`
+}
+
+// ScimUser defines model for ScimUser.
+type ScimUser struct {
+	Active      bool      `json:"active"`
+	DisplayName string    `json:"displayName"`
+	ExternalId  *string   `json:"externalId,omitempty"`
+	Groups      []ScimRef `json:"groups"`
+	Id          string    `json:"id"`
+	Meta        ScimMeta  `json:"meta"`
+	Schemas     []string  `json:"schemas"`
+	UserName    string    `json:"userName"`
+}
+
+// ScimUserList defines model for ScimUserList.
+type ScimUserList struct {
+	Resources    []ScimUser `json:"Resources"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Schemas      []string   `json:"schemas"`
+	StartIndex   int        `json:"startIndex"`
+	TotalResults int        `json:"totalResults"`
+}
+
+// ScimUserRequest Тело POST/PUT /Users; неизвестные атрибуты (name, emails, ...) игнорируются
+type ScimUserRequest struct {
+	// Active boolean; некоторые IdP присылают строку ("False"). По умолчанию true
+	Active     interface{} `json:"active,omitempty"`
+	ExternalId *string     `json:"externalId,omitempty"`
+	UserName   *string     `json:"userName,omitempty"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// Members user_id участников не повторяются
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool `json:"is_active"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId   UserId `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// TeamSLA defines model for TeamSLA.
+type TeamSLA struct {
+	// FirstResponseHours Сколько рабочих часов ревьювера есть на первый ответ
+	FirstResponseHours int `json:"first_response_hours"`
+
+	// Policy Что делать с просроченным ревью
+	Policy   *TeamSLAPolicy `json:"policy,omitempty"`
+	TeamName string         `json:"team_name"`
+}
+
+// TeamSLAPolicy Что делать с просроченным ревью
+type TeamSLAPolicy string
+
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int       `json:"id"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId   UserId `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// UserId ID пользователя, "u3" или "3"
+type UserId = string
+
+// WebhookResult defines model for WebhookResult.
+type WebhookResult struct {
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId *PullRequestId `json:"pull_request_id,omitempty"`
+
+	// Reason Почему событие пропущено (для ignored)
+	Reason *string             `json:"reason,omitempty"`
+	Status WebhookResultStatus `json:"status"`
+}
+
+// WebhookResultStatus defines model for WebhookResult.Status.
+type WebhookResultStatus string
+
+// WorkSchedule defines model for WorkSchedule.
+type WorkSchedule struct {
+	// TimeZone IANA, например Europe/Moscow
+	TimeZone string `json:"time_zone"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+
+	// WorkDays ISO - 1 понедельник ... 7 воскресенье
+	WorkDays []int  `json:"work_days"`
+	WorkEnd  string `json:"work_end"`
+
+	// WorkStart HH:MM, локальное время
+	WorkStart string `json:"work_start"`
+}
+
+// ScimCount defines model for ScimCount.
+type ScimCount = int
+
+// ScimFilter defines model for ScimFilter.
+type ScimFilter = string
+
+// ScimId defines model for ScimId.
+type ScimId = string
+
+// ScimStartIndex defines model for ScimStartIndex.
+type ScimStartIndex = int
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery ID пользователя, "u3" или "3"
+type UserIdQuery = UserId
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Error defines model for Error.
+type Error = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// CreateAPIKeyJSONBody defines parameters for CreateAPIKey.
+type CreateAPIKeyJSONBody struct {
+	Name string                   `json:"name"`
+	Role CreateAPIKeyJSONBodyRole `json:"role"`
+}
+
+// CreateAPIKeyJSONBodyRole defines parameters for CreateAPIKey.
+type CreateAPIKeyJSONBodyRole string
+
+// RevokeAPIKeyJSONBody defines parameters for RevokeAPIKey.
+type RevokeAPIKeyJSONBody struct {
+	Id int `json:"id"`
+}
+
+// ExportDataParams defines parameters for ExportData.
+type ExportDataParams struct {
+	Format *ExportDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Entity Обязателен для CSV
+	Entity *ExportDataParamsEntity `form:"entity,omitempty" json:"entity,omitempty"`
+}
+
+// ExportDataParamsFormat defines parameters for ExportData.
+type ExportDataParamsFormat string
+
+// ExportDataParamsEntity defines parameters for ExportData.
+type ExportDataParamsEntity string
+
+// ImportDataParams defines parameters for ImportData.
+type ImportDataParams struct {
+	// Format По умолчанию - по Content-Type
+	Format *ImportDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Entity Обязателен для CSV
+	Entity *ImportDataParamsEntity `form:"entity,omitempty" json:"entity,omitempty"`
+	DryRun *bool                   `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportDataParamsFormat defines parameters for ImportData.
+type ImportDataParamsFormat string
+
+// ImportDataParamsEntity defines parameters for ImportData.
+type ImportDataParamsEntity string
+
+// SetLogLevelJSONBody defines parameters for SetLogLevel.
+type SetLogLevelJSONBody struct {
+	// Level debug, info, warn, error, ...
+	Level string `json:"level"`
+}
+
+// ExecuteGraphQLGetParams defines parameters for ExecuteGraphQLGet.
+type ExecuteGraphQLGetParams struct {
+	Query         string  `form:"query" json:"query"`
+	OperationName *string `form:"operationName,omitempty" json:"operationName,omitempty"`
+
+	// Variables JSON-объект
+	Variables *string `form:"variables,omitempty" json:"variables,omitempty"`
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	// AuthorId ID пользователя, "u3" или "3"
+	AuthorId     UserId    `json:"author_id"`
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// PullRequestName Не пустое и не из одних пробелов
+	PullRequestName string `json:"pull_request_name"`
+
+	// TeamId Команда, из которой выбираются ревьюверы. Обязательна: пользователь может
+	// состоять в нескольких командах, и по автору команду PR не определить.
+	// Это числовой ID команды, а не пользователя, поэтому не UserId
+	TeamId int `json:"team_id"`
+}
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	PullRequestId PullRequestId `form:"pull_request_id" json:"pull_request_id"`
+
+	// IfNoneMatch ETag из предыдущего ответа
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// ListPullRequestsParams defines parameters for ListPullRequests.
+type ListPullRequestsParams struct {
+	Status     *PullRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+	TeamName   *string            `form:"team_name,omitempty" json:"team_name,omitempty"`
+	AuthorId   *UserId            `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *UserId            `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// CreatedFrom RFC3339 или YYYY-MM-DD, включительно
+	CreatedFrom *string `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo не включительно
+	CreatedTo  *string `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom *string `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo   *string `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+
+	// Q Подстрока в названии
+	Q     *string                     `form:"q,omitempty" json:"q,omitempty"`
+	Sort  *ListPullRequestsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit *int                        `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPullRequestsParamsSort defines parameters for ListPullRequests.
+type ListPullRequestsParamsSort string
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId PullRequestId `json:"pull_request_id"`
+}
+
+// ReassignReviewerJSONBody defines parameters for ReassignReviewer.
+type ReassignReviewerJSONBody struct {
+	// OldUserId ID пользователя, "u3" или "3"
+	OldUserId UserId `json:"old_user_id"`
+
+	// PullRequestId ID PR, "pr-1001" или "1001"
+	PullRequestId PullRequestId `json:"pull_request_id"`
+}
+
+// ListUnderstaffedPullRequestsParams defines parameters for ListUnderstaffedPullRequests.
+type ListUnderstaffedPullRequestsParams struct {
+	// TeamName Без параметра - по всем командам
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// ListScimGroupsParams defines parameters for ListScimGroups.
+type ListScimGroupsParams struct {
+	// Filter Только `<attr> eq "<value>"` (userName, displayName, externalId)
+	Filter *ScimFilter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex С 1; меньшие значения считаются 1
+	StartIndex *ScimStartIndex `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Размер страницы, не больше 500
+	Count *ScimCount `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// ListScimUsersParams defines parameters for ListScimUsers.
+type ListScimUsersParams struct {
+	// Filter Только `<attr> eq "<value>"` (userName, displayName, externalId)
+	Filter *ScimFilter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex С 1; меньшие значения считаются 1
+	StartIndex *ScimStartIndex `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Размер страницы, не больше 500
+	Count *ScimCount `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// AddOwnershipRuleJSONBody defines parameters for AddOwnershipRule.
+type AddOwnershipRuleJSONBody struct {
+	OwnerTeams *[]string `json:"owner_teams,omitempty"`
+
+	// Pattern Шаблон в синтаксисе CODEOWNERS
+	Pattern  string    `json:"pattern"`
+	TeamName string    `json:"team_name"`
+	UserIds  *[]UserId `json:"user_ids,omitempty"`
+}
+
+// DeleteHandleMappingJSONBody defines parameters for DeleteHandleMapping.
+type DeleteHandleMappingJSONBody struct {
+	Handle string `json:"handle"`
+}
+
+// DeleteOwnershipRuleJSONBody defines parameters for DeleteOwnershipRule.
+type DeleteOwnershipRuleJSONBody struct {
+	Id int `json:"id"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamSLAParams defines parameters for GetTeamSLA.
+type GetTeamSLAParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// ImportCodeownersTextBody defines parameters for ImportCodeowners.
+type ImportCodeownersTextBody = string
+
+// ImportCodeownersParams defines parameters for ImportCodeowners.
+type ImportCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// ListOwnershipRulesParams defines parameters for ListOwnershipRules.
+type ListOwnershipRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// SetTeamDefaultMaxOpenReviewsJSONBody defines parameters for SetTeamDefaultMaxOpenReviews.
+type SetTeamDefaultMaxOpenReviewsJSONBody struct {
+	// DefaultMaxOpenReviews null или отсутствует - без лимита
+	DefaultMaxOpenReviews *int   `json:"default_max_open_reviews"`
+	TeamName              string `json:"team_name"`
+}
+
+// SetTeamNotificationWebhookJSONBody defines parameters for SetTeamNotificationWebhook.
+type SetTeamNotificationWebhookJSONBody struct {
+	TeamName string `json:"team_name"`
+
+	// Url Абсолютный http(s) URL; пустая строка отключает уведомления
+	Url string `json:"url"`
+}
+
+// AddUserUnavailabilityJSONBody defines parameters for AddUserUnavailability.
+type AddUserUnavailabilityJSONBody struct {
+	// EndsAt Позже starts_at
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// DeleteUserUnavailabilityJSONBody defines parameters for DeleteUserUnavailability.
+type DeleteUserUnavailabilityJSONBody struct {
+	Id int `json:"id"`
+}
+
+// ListUserUnavailabilityParams defines parameters for ListUserUnavailability.
+type ListUserUnavailabilityParams struct {
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserReviewParams defines parameters for GetUserReview.
+type GetUserReviewParams struct {
+	// UserId Идентификатор пользователя; без него (или "me") - пользователь из JWT
+	UserId *string                  `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *PullRequestStatus       `form:"status,omitempty" json:"status,omitempty"`
+	Sort   *GetUserReviewParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Размер страницы. Без limit и cursor возвращаются все PR одним ответом, без next_cursor
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUserReviewParamsSort defines parameters for GetUserReview.
+type GetUserReviewParamsSort string
+
+// GetUserScheduleParams defines parameters for GetUserSchedule.
+type GetUserScheduleParams struct {
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// SetUserEmailJSONBody defines parameters for SetUserEmail.
+type SetUserEmailJSONBody struct {
+	// Email Пустая строка удаляет адрес
+	Email string `json:"email"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// SetUserIsActiveJSONBody defines parameters for SetUserIsActive.
+type SetUserIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// SetUserMaxOpenReviewsJSONBody defines parameters for SetUserMaxOpenReviews.
+type SetUserMaxOpenReviewsJSONBody struct {
+	// MaxOpenReviews null или отсутствует - лимит команды
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// SetUserNotificationsJSONBody defines parameters for SetUserNotifications.
+type SetUserNotificationsJSONBody struct {
+	Enabled bool `json:"enabled"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+// SetUserScheduleJSONBody defines parameters for SetUserSchedule.
+type SetUserScheduleJSONBody struct {
+	TimeZone *string `json:"time_zone,omitempty"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId   UserId `json:"user_id"`
+	WorkDays *[]int `json:"work_days,omitempty"`
+
+	// WorkEnd HH:MM
+	WorkEnd *string `json:"work_end,omitempty"`
+
+	// WorkStart HH:MM
+	WorkStart *string `json:"work_start,omitempty"`
+}
+
+// GitlabWebhookParams defines parameters for GitlabWebhook.
+type GitlabWebhookParams struct {
+	// XGitlabToken Секрет вебхука
+	XGitlabToken *string `json:"X-Gitlab-Token,omitempty"`
+
+	// XGitlabEvent Обрабатывается только "Merge Request Hook"
+	XGitlabEvent *string `json:"X-Gitlab-Event,omitempty"`
+}
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody CreateAPIKeyJSONBody
+
+// RevokeAPIKeyJSONRequestBody defines body for RevokeAPIKey for application/json ContentType.
+type RevokeAPIKeyJSONRequestBody RevokeAPIKeyJSONBody
+
+// ImportDataJSONRequestBody defines body for ImportData for application/json ContentType.
+type ImportDataJSONRequestBody = BulkData
+
+// SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
+type SetLogLevelJSONRequestBody SetLogLevelJSONBody
+
+// ExecuteGraphQLJSONRequestBody defines body for ExecuteGraphQL for application/json ContentType.
+type ExecuteGraphQLJSONRequestBody = GraphQLRequest
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignReviewerJSONRequestBody defines body for ReassignReviewer for application/json ContentType.
+type ReassignReviewerJSONRequestBody ReassignReviewerJSONBody
+
+// CreateScimGroupJSONRequestBody defines body for CreateScimGroup for application/json ContentType.
+type CreateScimGroupJSONRequestBody = ScimGroupRequest
+
+// CreateScimGroupApplicationScimPlusJSONRequestBody defines body for CreateScimGroup for application/scim+json ContentType.
+type CreateScimGroupApplicationScimPlusJSONRequestBody = ScimGroupRequest
+
+// PatchScimGroupJSONRequestBody defines body for PatchScimGroup for application/json ContentType.
+type PatchScimGroupJSONRequestBody = ScimPatchRequest
+
+// PatchScimGroupApplicationScimPlusJSONRequestBody defines body for PatchScimGroup for application/scim+json ContentType.
+type PatchScimGroupApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// ReplaceScimGroupJSONRequestBody defines body for ReplaceScimGroup for application/json ContentType.
+type ReplaceScimGroupJSONRequestBody = ScimGroupRequest
+
+// ReplaceScimGroupApplicationScimPlusJSONRequestBody defines body for ReplaceScimGroup for application/scim+json ContentType.
+type ReplaceScimGroupApplicationScimPlusJSONRequestBody = ScimGroupRequest
+
+// CreateScimUserJSONRequestBody defines body for CreateScimUser for application/json ContentType.
+type CreateScimUserJSONRequestBody = ScimUserRequest
+
+// CreateScimUserApplicationScimPlusJSONRequestBody defines body for CreateScimUser for application/scim+json ContentType.
+type CreateScimUserApplicationScimPlusJSONRequestBody = ScimUserRequest
+
+// PatchScimUserJSONRequestBody defines body for PatchScimUser for application/json ContentType.
+type PatchScimUserJSONRequestBody = ScimPatchRequest
+
+// PatchScimUserApplicationScimPlusJSONRequestBody defines body for PatchScimUser for application/scim+json ContentType.
+type PatchScimUserApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// ReplaceScimUserJSONRequestBody defines body for ReplaceScimUser for application/json ContentType.
+type ReplaceScimUserJSONRequestBody = ScimUserRequest
+
+// ReplaceScimUserApplicationScimPlusJSONRequestBody defines body for ReplaceScimUser for application/scim+json ContentType.
+type ReplaceScimUserApplicationScimPlusJSONRequestBody = ScimUserRequest
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// AddHandleMappingJSONRequestBody defines body for AddHandleMapping for application/json ContentType.
+type AddHandleMappingJSONRequestBody = HandleMapping
+
+// AddOwnershipRuleJSONRequestBody defines body for AddOwnershipRule for application/json ContentType.
+type AddOwnershipRuleJSONRequestBody AddOwnershipRuleJSONBody
+
+// DeleteHandleMappingJSONRequestBody defines body for DeleteHandleMapping for application/json ContentType.
+type DeleteHandleMappingJSONRequestBody DeleteHandleMappingJSONBody
+
+// DeleteOwnershipRuleJSONRequestBody defines body for DeleteOwnershipRule for application/json ContentType.
+type DeleteOwnershipRuleJSONRequestBody DeleteOwnershipRuleJSONBody
+
+// ImportCodeownersTextRequestBody defines body for ImportCodeowners for text/plain ContentType.
+type ImportCodeownersTextRequestBody = ImportCodeownersTextBody
+
+// SetTeamDefaultMaxOpenReviewsJSONRequestBody defines body for SetTeamDefaultMaxOpenReviews for application/json ContentType.
+type SetTeamDefaultMaxOpenReviewsJSONRequestBody SetTeamDefaultMaxOpenReviewsJSONBody
+
+// SetTeamNotificationWebhookJSONRequestBody defines body for SetTeamNotificationWebhook for application/json ContentType.
+type SetTeamNotificationWebhookJSONRequestBody SetTeamNotificationWebhookJSONBody
+
+// SetTeamSLAJSONRequestBody defines body for SetTeamSLA for application/json ContentType.
+type SetTeamSLAJSONRequestBody = TeamSLA
+
+// AddUserUnavailabilityJSONRequestBody defines body for AddUserUnavailability for application/json ContentType.
+type AddUserUnavailabilityJSONRequestBody AddUserUnavailabilityJSONBody
+
+// DeleteUserUnavailabilityJSONRequestBody defines body for DeleteUserUnavailability for application/json ContentType.
+type DeleteUserUnavailabilityJSONRequestBody DeleteUserUnavailabilityJSONBody
+
+// SetUserEmailJSONRequestBody defines body for SetUserEmail for application/json ContentType.
+type SetUserEmailJSONRequestBody SetUserEmailJSONBody
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody SetUserIsActiveJSONBody
+
+// SetUserMaxOpenReviewsJSONRequestBody defines body for SetUserMaxOpenReviews for application/json ContentType.
+type SetUserMaxOpenReviewsJSONRequestBody SetUserMaxOpenReviewsJSONBody
+
+// SetUserNotificationsJSONRequestBody defines body for SetUserNotifications for application/json ContentType.
+type SetUserNotificationsJSONRequestBody SetUserNotificationsJSONBody
+
+// SetUserScheduleJSONRequestBody defines body for SetUserSchedule for application/json ContentType.
+type SetUserScheduleJSONRequestBody SetUserScheduleJSONBody
+
+// GitlabWebhookJSONRequestBody defines body for GitlabWebhook for application/json ContentType.
+type GitlabWebhookJSONRequestBody = GitLabMergeRequestEvent
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys/create)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Все API-ключи, включая отозванные (без самих ключей)
+	// (GET /admin/apiKeys/list)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request)
+	// Выгрузить команды, пользователей, членство, PR и назначения
+	// (GET /admin/export)
+	ExportData(w http.ResponseWriter, r *http.Request, params ExportDataParams)
+	// Загрузить данные в формате экспорта (upsert в одной транзакции)
+	// (POST /admin/import)
+	ImportData(w http.ResponseWriter, r *http.Request, params ImportDataParams)
+	// Текущий уровень логирования
+	// (GET /admin/logLevel)
+	GetLogLevel(w http.ResponseWriter, r *http.Request)
+	// Сменить уровень логирования без перезапуска
+	// (POST /admin/logLevel)
+	SetLogLevel(w http.ResponseWriter, r *http.Request)
+	// Запрос GraphQL (только чтение) - для ссылок и кэширующих прокси
+	// (GET /graphql)
+	ExecuteGraphQLGet(w http.ResponseWriter, r *http.Request, params ExecuteGraphQLGetParams)
+	// Запрос GraphQL (только чтение)
+	// (POST /graphql)
+	ExecuteGraphQL(w http.ResponseWriter, r *http.Request)
+	// Liveness - процесс жив
+	// (GET /health/live)
+	HealthLive(w http.ResponseWriter, r *http.Request)
+	// Readiness - БД доступна, схема актуальна, фоновые задачи работают
+	// (GET /health/ready)
+	HealthReady(w http.ResponseWriter, r *http.Request)
+	// Метрики в текстовом формате Prometheus
+	// (GET /metrics)
+	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request)
+	// PR с ревьюверами, командой и историей назначений
+	// (GET /pullRequest/get)
+	GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams)
+	// Список PR с фильтрами, сортировкой и курсорной пагинацией
+	// (GET /pullRequest/list)
+	ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignReviewer(w http.ResponseWriter, r *http.Request)
+	// Открытые PR, у которых ревьюверов меньше, чем нужно
+	// (GET /pullRequest/understaffed)
+	ListUnderstaffedPullRequests(w http.ResponseWriter, r *http.Request, params ListUnderstaffedPullRequestsParams)
+	// Команды (фильтр и пагинация)
+	// (GET /scim/v2/Groups)
+	ListScimGroups(w http.ResponseWriter, r *http.Request, params ListScimGroupsParams)
+	// Создать команду
+	// (POST /scim/v2/Groups)
+	CreateScimGroup(w http.ResponseWriter, r *http.Request)
+	// Удалить команду (с PR - только очистить состав)
+	// (DELETE /scim/v2/Groups/{id})
+	DeleteScimGroup(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Команда
+	// (GET /scim/v2/Groups/{id})
+	GetScimGroup(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Изменить displayName, externalId или состав
+	// (PATCH /scim/v2/Groups/{id})
+	PatchScimGroup(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Заменить команду и её состав
+	// (PUT /scim/v2/Groups/{id})
+	ReplaceScimGroup(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Возможности SCIM-сервера
+	// (GET /scim/v2/ServiceProviderConfig)
+	GetScimServiceProviderConfig(w http.ResponseWriter, r *http.Request)
+	// Пользователи (фильтр и пагинация)
+	// (GET /scim/v2/Users)
+	ListScimUsers(w http.ResponseWriter, r *http.Request, params ListScimUsersParams)
+	// Создать пользователя или привязать существующего с тем же именем
+	// (POST /scim/v2/Users)
+	CreateScimUser(w http.ResponseWriter, r *http.Request)
+	// Деактивировать пользователя и исключить из команд
+	// (DELETE /scim/v2/Users/{id})
+	DeleteScimUser(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Пользователь
+	// (GET /scim/v2/Users/{id})
+	GetScimUser(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Изменить active, userName или externalId
+	// (PATCH /scim/v2/Users/{id})
+	PatchScimUser(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Заменить пользователя; active false передаёт его открытые ревью
+	// (PUT /scim/v2/Users/{id})
+	ReplaceScimUser(w http.ResponseWriter, r *http.Request, id ScimId)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(w http.ResponseWriter, r *http.Request)
+	// Сопоставить владельца из CODEOWNERS пользователю или команде
+	// (POST /team/addHandleMapping)
+	AddHandleMapping(w http.ResponseWriter, r *http.Request)
+	// Добавить правило владения путями в конец списка правил команды
+	// (POST /team/addOwnershipRule)
+	AddOwnershipRule(w http.ResponseWriter, r *http.Request)
+	// Удалить соответствие владельца CODEOWNERS
+	// (POST /team/deleteHandleMapping)
+	DeleteHandleMapping(w http.ResponseWriter, r *http.Request)
+	// Удалить правило владения путями
+	// (POST /team/deleteOwnershipRule)
+	DeleteOwnershipRule(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// SLA команды
+	// (GET /team/getSLA)
+	GetTeamSLA(w http.ResponseWriter, r *http.Request, params GetTeamSLAParams)
+	// Все соответствия владельцев CODEOWNERS
+	// (GET /team/handleMappings)
+	ListHandleMappings(w http.ResponseWriter, r *http.Request)
+	// Заменить правила команды содержимым файла CODEOWNERS
+	// (POST /team/importCodeowners)
+	ImportCodeowners(w http.ResponseWriter, r *http.Request, params ImportCodeownersParams)
+	// Правила владения путями команды в порядке применения
+	// (GET /team/ownershipRules)
+	ListOwnershipRules(w http.ResponseWriter, r *http.Request, params ListOwnershipRulesParams)
+	// Лимит открытых ревью на участника команды по умолчанию
+	// (POST /team/setDefaultMaxOpenReviews)
+	SetTeamDefaultMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Вебхук уведомлений команды (Slack/Mattermost-совместимый)
+	// (POST /team/setNotificationWebhook)
+	SetTeamNotificationWebhook(w http.ResponseWriter, r *http.Request)
+	// SLA первого ответа ревьювера и политика для просроченных ревью
+	// (POST /team/setSLA)
+	SetTeamSLA(w http.ResponseWriter, r *http.Request)
+	// Период отсутствия (отпуск, больничный) - в это время ревью не назначаются
+	// (POST /users/availability/add)
+	AddUserUnavailability(w http.ResponseWriter, r *http.Request)
+	// Удалить период отсутствия
+	// (POST /users/availability/delete)
+	DeleteUserUnavailability(w http.ResponseWriter, r *http.Request)
+	// Периоды отсутствия пользователя
+	// (GET /users/availability/list)
+	ListUserUnavailability(w http.ResponseWriter, r *http.Request, params ListUserUnavailabilityParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReview(w http.ResponseWriter, r *http.Request, params GetUserReviewParams)
+	// Рабочее расписание пользователя
+	// (GET /users/getSchedule)
+	GetUserSchedule(w http.ResponseWriter, r *http.Request, params GetUserScheduleParams)
+	// Адрес для ежедневного дайджеста ревью
+	// (POST /users/setEmail)
+	SetUserEmail(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(w http.ResponseWriter, r *http.Request)
+	// Личный лимит открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Включить или отключить уведомления пользователя в чате
+	// (POST /users/setNotifications)
+	SetUserNotifications(w http.ResponseWriter, r *http.Request)
+	// Рабочее расписание пользователя (для SLA и назначения ревью)
+	// (POST /users/setSchedule)
+	SetUserSchedule(w http.ResponseWriter, r *http.Request)
+	// Вебхук merge request из GitLab
+	// (POST /webhooks/gitlab)
+	GitlabWebhook(w http.ResponseWriter, r *http.Request, params GitlabWebhookParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Выпустить API-ключ
+// (POST /admin/apiKeys/create)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Все API-ключи, включая отозванные (без самих ключей)
+// (GET /admin/apiKeys/list)
+func (_ Unimplemented) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API-ключ
+// (POST /admin/apiKeys/revoke)
+func (_ Unimplemented) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить команды, пользователей, членство, PR и назначения
+// (GET /admin/export)
+func (_ Unimplemented) ExportData(w http.ResponseWriter, r *http.Request, params ExportDataParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить данные в формате экспорта (upsert в одной транзакции)
+// (POST /admin/import)
+func (_ Unimplemented) ImportData(w http.ResponseWriter, r *http.Request, params ImportDataParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Текущий уровень логирования
+// (GET /admin/logLevel)
+func (_ Unimplemented) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сменить уровень логирования без перезапуска
+// (POST /admin/logLevel)
+func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запрос GraphQL (только чтение) - для ссылок и кэширующих прокси
+// (GET /graphql)
+func (_ Unimplemented) ExecuteGraphQLGet(w http.ResponseWriter, r *http.Request, params ExecuteGraphQLGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запрос GraphQL (только чтение)
+// (POST /graphql)
+func (_ Unimplemented) ExecuteGraphQL(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Liveness - процесс жив
+// (GET /health/live)
+func (_ Unimplemented) HealthLive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness - БД доступна, схема актуальна, фоновые задачи работают
+// (GET /health/ready)
+func (_ Unimplemented) HealthReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Метрики в текстовом формате Prometheus
+// (GET /metrics)
+func (_ Unimplemented) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды
+// (POST /pullRequest/create)
+func (_ Unimplemented) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// PR с ревьюверами, командой и историей назначений
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список PR с фильтрами, сортировкой и курсорной пагинацией
+// (GET /pullRequest/list)
+func (_ Unimplemented) ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открытые PR, у которых ревьюверов меньше, чем нужно
+// (GET /pullRequest/understaffed)
+func (_ Unimplemented) ListUnderstaffedPullRequests(w http.ResponseWriter, r *http.Request, params ListUnderstaffedPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Команды (фильтр и пагинация)
+// (GET /scim/v2/Groups)
+func (_ Unimplemented) ListScimGroups(w http.ResponseWriter, r *http.Request, params ListScimGroupsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду
+// (POST /scim/v2/Groups)
+func (_ Unimplemented) CreateScimGroup(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду (с PR - только очистить состав)
+// (DELETE /scim/v2/Groups/{id})
+func (_ Unimplemented) DeleteScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Команда
+// (GET /scim/v2/Groups/{id})
+func (_ Unimplemented) GetScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить displayName, externalId или состав
+// (PATCH /scim/v2/Groups/{id})
+func (_ Unimplemented) PatchScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить команду и её состав
+// (PUT /scim/v2/Groups/{id})
+func (_ Unimplemented) ReplaceScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Возможности SCIM-сервера
+// (GET /scim/v2/ServiceProviderConfig)
+func (_ Unimplemented) GetScimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пользователи (фильтр и пагинация)
+// (GET /scim/v2/Users)
+func (_ Unimplemented) ListScimUsers(w http.ResponseWriter, r *http.Request, params ListScimUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать пользователя или привязать существующего с тем же именем
+// (POST /scim/v2/Users)
+func (_ Unimplemented) CreateScimUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Деактивировать пользователя и исключить из команд
+// (DELETE /scim/v2/Users/{id})
+func (_ Unimplemented) DeleteScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пользователь
+// (GET /scim/v2/Users/{id})
+func (_ Unimplemented) GetScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить active, userName или externalId
+// (PATCH /scim/v2/Users/{id})
+func (_ Unimplemented) PatchScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить пользователя; active false передаёт его открытые ревью
+// (PUT /scim/v2/Users/{id})
+func (_ Unimplemented) ReplaceScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) AddTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сопоставить владельца из CODEOWNERS пользователю или команде
+// (POST /team/addHandleMapping)
+func (_ Unimplemented) AddHandleMapping(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить правило владения путями в конец списка правил команды
+// (POST /team/addOwnershipRule)
+func (_ Unimplemented) AddOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить соответствие владельца CODEOWNERS
+// (POST /team/deleteHandleMapping)
+func (_ Unimplemented) DeleteHandleMapping(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить правило владения путями
+// (POST /team/deleteOwnershipRule)
+func (_ Unimplemented) DeleteOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SLA команды
+// (GET /team/getSLA)
+func (_ Unimplemented) GetTeamSLA(w http.ResponseWriter, r *http.Request, params GetTeamSLAParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Все соответствия владельцев CODEOWNERS
+// (GET /team/handleMappings)
+func (_ Unimplemented) ListHandleMappings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить правила команды содержимым файла CODEOWNERS
+// (POST /team/importCodeowners)
+func (_ Unimplemented) ImportCodeowners(w http.ResponseWriter, r *http.Request, params ImportCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Правила владения путями команды в порядке применения
+// (GET /team/ownershipRules)
+func (_ Unimplemented) ListOwnershipRules(w http.ResponseWriter, r *http.Request, params ListOwnershipRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Лимит открытых ревью на участника команды по умолчанию
+// (POST /team/setDefaultMaxOpenReviews)
+func (_ Unimplemented) SetTeamDefaultMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вебхук уведомлений команды (Slack/Mattermost-совместимый)
+// (POST /team/setNotificationWebhook)
+func (_ Unimplemented) SetTeamNotificationWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SLA первого ответа ревьювера и политика для просроченных ревью
+// (POST /team/setSLA)
+func (_ Unimplemented) SetTeamSLA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Период отсутствия (отпуск, больничный) - в это время ревью не назначаются
+// (POST /users/availability/add)
+func (_ Unimplemented) AddUserUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить период отсутствия
+// (POST /users/availability/delete)
+func (_ Unimplemented) DeleteUserUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Периоды отсутствия пользователя
+// (GET /users/availability/list)
+func (_ Unimplemented) ListUserUnavailability(w http.ResponseWriter, r *http.Request, params ListUserUnavailabilityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUserReview(w http.ResponseWriter, r *http.Request, params GetUserReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Рабочее расписание пользователя
+// (GET /users/getSchedule)
+func (_ Unimplemented) GetUserSchedule(w http.ResponseWriter, r *http.Request, params GetUserScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Адрес для ежедневного дайджеста ревью
+// (POST /users/setEmail)
+func (_ Unimplemented) SetUserEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Личный лимит открытых ревью пользователя
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включить или отключить уведомления пользователя в чате
+// (POST /users/setNotifications)
+func (_ Unimplemented) SetUserNotifications(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Рабочее расписание пользователя (для SLA и назначения ревью)
+// (POST /users/setSchedule)
+func (_ Unimplemented) SetUserSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вебхук merge request из GitLab
+// (POST /webhooks/gitlab)
+func (_ Unimplemented) GitlabWebhook(w http.ResponseWriter, r *http.Request, params GitlabWebhookParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAPIKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportData operation middleware
+func (siw *ServerInterfaceWrapper) ExportData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDataParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportData operation middleware
+func (siw *ServerInterfaceWrapper) ImportData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportDataParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLogLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecuteGraphQLGet operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteGraphQLGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExecuteGraphQLGetParams
+
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operationName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operationName", r.URL.Query(), &params.OperationName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "variables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecuteGraphQLGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecuteGraphQL operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteGraphQL(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecuteGraphQL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HealthLive operation middleware
+func (siw *ServerInterfaceWrapper) HealthLive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HealthLive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HealthReady operation middleware
+func (siw *ServerInterfaceWrapper) HealthReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HealthReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPullRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", r.URL.Query(), &params.MergedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", r.URL.Query(), &params.MergedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignReviewer operation middleware
+func (siw *ServerInterfaceWrapper) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignReviewer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUnderstaffedPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListUnderstaffedPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUnderstaffedPullRequestsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUnderstaffedPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListScimGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListScimGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScimGroupsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", r.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startIndex", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScimGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateScimGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateScimGroup(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateScimGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScimGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScimGroup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetScimGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimGroup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchScimGroup operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchScimGroup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceScimGroup operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceScimGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceScimGroup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimServiceProviderConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetScimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimServiceProviderConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListScimUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListScimUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScimUsersParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", r.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startIndex", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScimUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateScimUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateScimUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateScimUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScimUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScimUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimUser operation middleware
+func (siw *ServerInterfaceWrapper) GetScimUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchScimUser operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchScimUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceScimUser operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceScimUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceScimUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddHandleMapping operation middleware
+func (siw *ServerInterfaceWrapper) AddHandleMapping(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddHandleMapping(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddOwnershipRule operation middleware
+func (siw *ServerInterfaceWrapper) AddOwnershipRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOwnershipRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHandleMapping operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHandleMapping(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHandleMapping(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOwnershipRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOwnershipRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOwnershipRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamSLA operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSLA(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSLAParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSLA(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHandleMappings operation middleware
+func (siw *ServerInterfaceWrapper) ListHandleMappings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHandleMappings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) ImportCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOwnershipRules operation middleware
+func (siw *ServerInterfaceWrapper) ListOwnershipRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOwnershipRulesParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOwnershipRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamDefaultMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamDefaultMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamDefaultMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamNotificationWebhook operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamNotificationWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamNotificationWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamSLA operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamSLA(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamSLA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUserUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) AddUserUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUserUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) ListUserUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserUnavailabilityParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserUnavailability(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserScheduleParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserEmail operation middleware
+func (siw *ServerInterfaceWrapper) SetUserEmail(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserNotifications operation middleware
+func (siw *ServerInterfaceWrapper) SetUserNotifications(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserNotifications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserSchedule operation middleware
+func (siw *ServerInterfaceWrapper) SetUserSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GitlabWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GitlabWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GitlabWebhookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Token", Err: err})
+			return
+		}
+
+		params.XGitlabToken = &XGitlabToken
+
+	}
+
+	// ------------- Optional header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Event", Err: err})
+			return
+		}
+
+		params.XGitlabEvent = &XGitlabEvent
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GitlabWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/apiKeys/create", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/apiKeys/list", wrapper.ListAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/apiKeys/revoke", wrapper.RevokeAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export", wrapper.ExportData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.ImportData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/logLevel", wrapper.GetLogLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/logLevel", wrapper.SetLogLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/graphql", wrapper.ExecuteGraphQLGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/graphql", wrapper.ExecuteGraphQL)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/live", wrapper.HealthLive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.HealthReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/metrics", wrapper.GetMetrics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.ListPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/understaffed", wrapper.ListUnderstaffedPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scim/v2/Groups", wrapper.ListScimGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/scim/v2/Groups", wrapper.CreateScimGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/scim/v2/Groups/{id}", wrapper.DeleteScimGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scim/v2/Groups/{id}", wrapper.GetScimGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/scim/v2/Groups/{id}", wrapper.PatchScimGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/scim/v2/Groups/{id}", wrapper.ReplaceScimGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scim/v2/ServiceProviderConfig", wrapper.GetScimServiceProviderConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scim/v2/Users", wrapper.ListScimUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/scim/v2/Users", wrapper.CreateScimUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/scim/v2/Users/{id}", wrapper.DeleteScimUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scim/v2/Users/{id}", wrapper.GetScimUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/scim/v2/Users/{id}", wrapper.PatchScimUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/scim/v2/Users/{id}", wrapper.ReplaceScimUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.AddTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addHandleMapping", wrapper.AddHandleMapping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addOwnershipRule", wrapper.AddOwnershipRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deleteHandleMapping", wrapper.DeleteHandleMapping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deleteOwnershipRule", wrapper.DeleteOwnershipRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getSLA", wrapper.GetTeamSLA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/handleMappings", wrapper.ListHandleMappings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/importCodeowners", wrapper.ImportCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/ownershipRules", wrapper.ListOwnershipRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setDefaultMaxOpenReviews", wrapper.SetTeamDefaultMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setNotificationWebhook", wrapper.SetTeamNotificationWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSLA", wrapper.SetTeamSLA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/availability/add", wrapper.AddUserUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/availability/delete", wrapper.DeleteUserUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/availability/list", wrapper.ListUserUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUserReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getSchedule", wrapper.GetUserSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setEmail", wrapper.SetUserEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.SetUserIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.SetUserMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setNotifications", wrapper.SetUserNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSchedule", wrapper.SetUserSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/gitlab", wrapper.GitlabWebhook)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type ErrorJSONResponse ErrorResponse
+
+type ForbiddenJSONResponse ErrorResponse
+
+type NotFoundJSONResponse ErrorResponse
+
+type ScimErrorApplicationScimPlusJSONResponse ScimError
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
+}
+
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIKey201JSONResponse struct {
+	ApiKey APIKey `json:"api_key"`
+	Key    string `json:"key"`
+}
+
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateAPIKey400JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateAPIKey403JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKeydefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response CreateAPIKeydefaultJSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListAPIKeysRequestObject struct {
+}
+
+type ListAPIKeysResponseObject interface {
+	VisitListAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type ListAPIKeys200JSONResponse struct {
+	ApiKeys []APIKey `json:"api_keys"`
+}
+
+func (response ListAPIKeys200JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPIKeys401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAPIKeys401JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPIKeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListAPIKeys403JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPIKeysdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListAPIKeysdefaultJSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RevokeAPIKeyRequestObject struct {
+	Body *RevokeAPIKeyJSONRequestBody
+}
+
+type RevokeAPIKeyResponseObject interface {
+	VisitRevokeAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeAPIKey200JSONResponse struct {
+	Id      int  `json:"id"`
+	Revoked bool `json:"revoked"`
+}
+
+func (response RevokeAPIKey200JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeAPIKey400JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeAPIKey401JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeAPIKey403JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeAPIKey404JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKeydefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response RevokeAPIKeydefaultJSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportDataRequestObject struct {
+	Params ExportDataParams
+}
+
+type ExportDataResponseObject interface {
+	VisitExportDataResponse(w http.ResponseWriter) error
+}
+
+type ExportData200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportData200JSONResponse struct {
+	Body    BulkData
+	Headers ExportData200ResponseHeaders
+}
+
+func (response ExportData200JSONResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportData200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       ExportData200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportData200TextcsvResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportData400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportData400JSONResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportData401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportData401JSONResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportData403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportData403JSONResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDatadefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ExportDatadefaultJSONResponse) VisitExportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportDataRequestObject struct {
+	Params   ImportDataParams
+	JSONBody *ImportDataJSONRequestBody
+	Body     io.Reader
+}
+
+type ImportDataResponseObject interface {
+	VisitImportDataResponse(w http.ResponseWriter) error
+}
+
+type ImportData200JSONResponse ImportResult
+
+func (response ImportData200JSONResponse) VisitImportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportData400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportData400JSONResponse) VisitImportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportData401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportData401JSONResponse) VisitImportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportData403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportData403JSONResponse) VisitImportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportDatadefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ImportDatadefaultJSONResponse) VisitImportDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetLogLevelRequestObject struct {
+}
+
+type GetLogLevelResponseObject interface {
+	VisitGetLogLevelResponse(w http.ResponseWriter) error
+}
+
+type GetLogLevel200JSONResponse LogLevel
+
+func (response GetLogLevel200JSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogLevel401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetLogLevel401JSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogLevel403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetLogLevel403JSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogLeveldefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetLogLeveldefaultJSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetLogLevelRequestObject struct {
+	Body *SetLogLevelJSONRequestBody
+}
+
+type SetLogLevelResponseObject interface {
+	VisitSetLogLevelResponse(w http.ResponseWriter) error
+}
+
+type SetLogLevel200JSONResponse LogLevel
+
+func (response SetLogLevel200JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevel400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetLogLevel400JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevel401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetLogLevel401JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevel403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetLogLevel403JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLeveldefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetLogLeveldefaultJSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExecuteGraphQLGetRequestObject struct {
+	Params ExecuteGraphQLGetParams
+}
+
+type ExecuteGraphQLGetResponseObject interface {
+	VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error
+}
+
+type ExecuteGraphQLGet200JSONResponse GraphQLResponse
+
+func (response ExecuteGraphQLGet200JSONResponse) VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQLGet400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExecuteGraphQLGet400JSONResponse) VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQLGet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExecuteGraphQLGet401JSONResponse) VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQLGet403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExecuteGraphQLGet403JSONResponse) VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQLGetdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ExecuteGraphQLGetdefaultJSONResponse) VisitExecuteGraphQLGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExecuteGraphQLRequestObject struct {
+	Body *ExecuteGraphQLJSONRequestBody
+}
+
+type ExecuteGraphQLResponseObject interface {
+	VisitExecuteGraphQLResponse(w http.ResponseWriter) error
+}
+
+type ExecuteGraphQL200JSONResponse GraphQLResponse
+
+func (response ExecuteGraphQL200JSONResponse) VisitExecuteGraphQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQL400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExecuteGraphQL400JSONResponse) VisitExecuteGraphQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQL401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExecuteGraphQL401JSONResponse) VisitExecuteGraphQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQL403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExecuteGraphQL403JSONResponse) VisitExecuteGraphQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteGraphQLdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ExecuteGraphQLdefaultJSONResponse) VisitExecuteGraphQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HealthLiveRequestObject struct {
+}
+
+type HealthLiveResponseObject interface {
+	VisitHealthLiveResponse(w http.ResponseWriter) error
+}
+
+type HealthLive200JSONResponse struct {
+	Status string `json:"status"`
+}
+
+func (response HealthLive200JSONResponse) VisitHealthLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthReadyRequestObject struct {
+}
+
+type HealthReadyResponseObject interface {
+	VisitHealthReadyResponse(w http.ResponseWriter) error
+}
+
+type HealthReady200JSONResponse ReadinessResponse
+
+func (response HealthReady200JSONResponse) VisitHealthReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthReady503JSONResponse ReadinessResponse
+
+func (response HealthReady503JSONResponse) VisitHealthReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetricsRequestObject struct {
+}
+
+type GetMetricsResponseObject interface {
+	VisitGetMetricsResponse(w http.ResponseWriter) error
+}
+
+type GetMetrics200TextResponse string
+
+func (response GetMetrics200TextResponse) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type CreatePullRequestRequestObject struct {
+	Body *CreatePullRequestJSONRequestBody
+}
+
+type CreatePullRequestResponseObject interface {
+	VisitCreatePullRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePullRequest201JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response CreatePullRequest201JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePullRequest400JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreatePullRequest401JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePullRequest403JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response CreatePullRequestdefaultJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestRequestObject struct {
+	Params GetPullRequestParams
+}
+
+type GetPullRequestResponseObject interface {
+	VisitGetPullRequestResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequest200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetPullRequest200JSONResponse struct {
+	Body struct {
+		Pr PullRequestDetails `json:"pr"`
+	}
+	Headers GetPullRequest200ResponseHeaders
+}
+
+func (response GetPullRequest200JSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequest304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetPullRequest304Response struct {
+	Headers GetPullRequest304ResponseHeaders
+}
+
+func (response GetPullRequest304Response) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetPullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequest400JSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPullRequest401JSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPullRequest403JSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPullRequest404JSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetPullRequestdefaultJSONResponse) VisitGetPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPullRequestsRequestObject struct {
+	Params ListPullRequestsParams
+}
+
+type ListPullRequestsResponseObject interface {
+	VisitListPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListPullRequests200JSONResponse PullRequestPage
+
+func (response ListPullRequests200JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests400JSONResponse ErrorResponse
+
+func (response ListPullRequests400JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPullRequests401JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListPullRequests403JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListPullRequestsdefaultJSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePullRequestRequestObject struct {
+	Body *MergePullRequestJSONRequestBody
+}
+
+type MergePullRequestResponseObject interface {
+	VisitMergePullRequestResponse(w http.ResponseWriter) error
+}
+
+type MergePullRequest200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response MergePullRequest200JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MergePullRequest400JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MergePullRequest401JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response MergePullRequest403JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response MergePullRequest404JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response MergePullRequestdefaultJSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignReviewerRequestObject struct {
+	Body *ReassignReviewerJSONRequestBody
+}
+
+type ReassignReviewerResponseObject interface {
+	VisitReassignReviewerResponse(w http.ResponseWriter) error
+}
+
+type ReassignReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy ID пользователя, "u3" или "3"
+	ReplacedBy UserId `json:"replaced_by"`
+}
+
+func (response ReassignReviewer200JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReassignReviewer400JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReassignReviewer401JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReassignReviewer403JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer404JSONResponse ErrorResponse
+
+func (response ReassignReviewer404JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer409JSONResponse ErrorResponse
+
+func (response ReassignReviewer409JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewerdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ReassignReviewerdefaultJSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListUnderstaffedPullRequestsRequestObject struct {
+	Params ListUnderstaffedPullRequestsParams
+}
+
+type ListUnderstaffedPullRequestsResponseObject interface {
+	VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListUnderstaffedPullRequests200JSONResponse struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+func (response ListUnderstaffedPullRequests200JSONResponse) VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnderstaffedPullRequests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListUnderstaffedPullRequests400JSONResponse) VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnderstaffedPullRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUnderstaffedPullRequests401JSONResponse) VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnderstaffedPullRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListUnderstaffedPullRequests403JSONResponse) VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnderstaffedPullRequestsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListUnderstaffedPullRequestsdefaultJSONResponse) VisitListUnderstaffedPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListScimGroupsRequestObject struct {
+	Params ListScimGroupsParams
+}
+
+type ListScimGroupsResponseObject interface {
+	VisitListScimGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListScimGroups200ApplicationScimPlusJSONResponse ScimGroupList
+
+func (response ListScimGroups200ApplicationScimPlusJSONResponse) VisitListScimGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimGroups401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListScimGroups401JSONResponse) VisitListScimGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimGroups403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListScimGroups403JSONResponse) VisitListScimGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimGroupsdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response ListScimGroupsdefaultApplicationScimPlusJSONResponse) VisitListScimGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateScimGroupRequestObject struct {
+	JSONBody                    *CreateScimGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *CreateScimGroupApplicationScimPlusJSONRequestBody
+}
+
+type CreateScimGroupResponseObject interface {
+	VisitCreateScimGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateScimGroup201ResponseHeaders struct {
+	Location string
+}
+
+type CreateScimGroup201ApplicationScimPlusJSONResponse struct {
+	Body    ScimGroup
+	Headers CreateScimGroup201ResponseHeaders
+}
+
+func (response CreateScimGroup201ApplicationScimPlusJSONResponse) VisitCreateScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateScimGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateScimGroup401JSONResponse) VisitCreateScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScimGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateScimGroup403JSONResponse) VisitCreateScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScimGroupdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response CreateScimGroupdefaultApplicationScimPlusJSONResponse) VisitCreateScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteScimGroupRequestObject struct {
+	Id ScimId `json:"id"`
+}
+
+type DeleteScimGroupResponseObject interface {
+	VisitDeleteScimGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimGroup204Response struct {
+}
+
+func (response DeleteScimGroup204Response) VisitDeleteScimGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteScimGroup401JSONResponse) VisitDeleteScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteScimGroup403JSONResponse) VisitDeleteScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimGroupdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response DeleteScimGroupdefaultApplicationScimPlusJSONResponse) VisitDeleteScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimGroupRequestObject struct {
+	Id ScimId `json:"id"`
+}
+
+type GetScimGroupResponseObject interface {
+	VisitGetScimGroupResponse(w http.ResponseWriter) error
+}
+
+type GetScimGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response GetScimGroup200ApplicationScimPlusJSONResponse) VisitGetScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetScimGroup401JSONResponse) VisitGetScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetScimGroup403JSONResponse) VisitGetScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimGroupdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimGroupdefaultApplicationScimPlusJSONResponse) VisitGetScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchScimGroupRequestObject struct {
+	Id                          ScimId `json:"id"`
+	JSONBody                    *PatchScimGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *PatchScimGroupApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimGroupResponseObject interface {
+	VisitPatchScimGroupResponse(w http.ResponseWriter) error
+}
+
+type PatchScimGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response PatchScimGroup200ApplicationScimPlusJSONResponse) VisitPatchScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PatchScimGroup401JSONResponse) VisitPatchScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PatchScimGroup403JSONResponse) VisitPatchScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimGroupdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PatchScimGroupdefaultApplicationScimPlusJSONResponse) VisitPatchScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceScimGroupRequestObject struct {
+	Id                          ScimId `json:"id"`
+	JSONBody                    *ReplaceScimGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *ReplaceScimGroupApplicationScimPlusJSONRequestBody
+}
+
+type ReplaceScimGroupResponseObject interface {
+	VisitReplaceScimGroupResponse(w http.ResponseWriter) error
+}
+
+type ReplaceScimGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response ReplaceScimGroup200ApplicationScimPlusJSONResponse) VisitReplaceScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReplaceScimGroup401JSONResponse) VisitReplaceScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReplaceScimGroup403JSONResponse) VisitReplaceScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimGroupdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response ReplaceScimGroupdefaultApplicationScimPlusJSONResponse) VisitReplaceScimGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimServiceProviderConfigRequestObject struct {
+}
+
+type GetScimServiceProviderConfigResponseObject interface {
+	VisitGetScimServiceProviderConfigResponse(w http.ResponseWriter) error
+}
+
+type GetScimServiceProviderConfig200ApplicationScimPlusJSONResponse ScimServiceProviderConfig
+
+func (response GetScimServiceProviderConfig200ApplicationScimPlusJSONResponse) VisitGetScimServiceProviderConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimServiceProviderConfig401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetScimServiceProviderConfig401JSONResponse) VisitGetScimServiceProviderConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimServiceProviderConfig403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetScimServiceProviderConfig403JSONResponse) VisitGetScimServiceProviderConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimServiceProviderConfigdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimServiceProviderConfigdefaultApplicationScimPlusJSONResponse) VisitGetScimServiceProviderConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListScimUsersRequestObject struct {
+	Params ListScimUsersParams
+}
+
+type ListScimUsersResponseObject interface {
+	VisitListScimUsersResponse(w http.ResponseWriter) error
+}
+
+type ListScimUsers200ApplicationScimPlusJSONResponse ScimUserList
+
+func (response ListScimUsers200ApplicationScimPlusJSONResponse) VisitListScimUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListScimUsers401JSONResponse) VisitListScimUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimUsers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListScimUsers403JSONResponse) VisitListScimUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScimUsersdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response ListScimUsersdefaultApplicationScimPlusJSONResponse) VisitListScimUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateScimUserRequestObject struct {
+	JSONBody                    *CreateScimUserJSONRequestBody
+	ApplicationScimPlusJSONBody *CreateScimUserApplicationScimPlusJSONRequestBody
+}
+
+type CreateScimUserResponseObject interface {
+	VisitCreateScimUserResponse(w http.ResponseWriter) error
+}
+
+type CreateScimUser201ResponseHeaders struct {
+	Location string
+}
+
+type CreateScimUser201ApplicationScimPlusJSONResponse struct {
+	Body    ScimUser
+	Headers CreateScimUser201ResponseHeaders
+}
+
+func (response CreateScimUser201ApplicationScimPlusJSONResponse) VisitCreateScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateScimUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateScimUser401JSONResponse) VisitCreateScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScimUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateScimUser403JSONResponse) VisitCreateScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScimUserdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response CreateScimUserdefaultApplicationScimPlusJSONResponse) VisitCreateScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteScimUserRequestObject struct {
+	Id ScimId `json:"id"`
+}
+
+type DeleteScimUserResponseObject interface {
+	VisitDeleteScimUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimUser204Response struct {
+}
+
+func (response DeleteScimUser204Response) VisitDeleteScimUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteScimUser401JSONResponse) VisitDeleteScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteScimUser403JSONResponse) VisitDeleteScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimUserdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response DeleteScimUserdefaultApplicationScimPlusJSONResponse) VisitDeleteScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimUserRequestObject struct {
+	Id ScimId `json:"id"`
+}
+
+type GetScimUserResponseObject interface {
+	VisitGetScimUserResponse(w http.ResponseWriter) error
+}
+
+type GetScimUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response GetScimUser200ApplicationScimPlusJSONResponse) VisitGetScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetScimUser401JSONResponse) VisitGetScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetScimUser403JSONResponse) VisitGetScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimUserdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimUserdefaultApplicationScimPlusJSONResponse) VisitGetScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchScimUserRequestObject struct {
+	Id                          ScimId `json:"id"`
+	JSONBody                    *PatchScimUserJSONRequestBody
+	ApplicationScimPlusJSONBody *PatchScimUserApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimUserResponseObject interface {
+	VisitPatchScimUserResponse(w http.ResponseWriter) error
+}
+
+type PatchScimUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response PatchScimUser200ApplicationScimPlusJSONResponse) VisitPatchScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PatchScimUser401JSONResponse) VisitPatchScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PatchScimUser403JSONResponse) VisitPatchScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimUserdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PatchScimUserdefaultApplicationScimPlusJSONResponse) VisitPatchScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceScimUserRequestObject struct {
+	Id                          ScimId `json:"id"`
+	JSONBody                    *ReplaceScimUserJSONRequestBody
+	ApplicationScimPlusJSONBody *ReplaceScimUserApplicationScimPlusJSONRequestBody
+}
+
+type ReplaceScimUserResponseObject interface {
+	VisitReplaceScimUserResponse(w http.ResponseWriter) error
+}
+
+type ReplaceScimUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response ReplaceScimUser200ApplicationScimPlusJSONResponse) VisitReplaceScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReplaceScimUser401JSONResponse) VisitReplaceScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReplaceScimUser403JSONResponse) VisitReplaceScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceScimUserdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response ReplaceScimUserdefaultApplicationScimPlusJSONResponse) VisitReplaceScimUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddTeamRequestObject struct {
+	Body *AddTeamJSONRequestBody
+}
+
+type AddTeamResponseObject interface {
+	VisitAddTeamResponse(w http.ResponseWriter) error
+}
+
+type AddTeam201JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response AddTeam201JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam400JSONResponse ErrorResponse
+
+func (response AddTeam400JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddTeam401JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddTeam403JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response AddTeamdefaultJSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddHandleMappingRequestObject struct {
+	Body *AddHandleMappingJSONRequestBody
+}
+
+type AddHandleMappingResponseObject interface {
+	VisitAddHandleMappingResponse(w http.ResponseWriter) error
+}
+
+type AddHandleMapping201JSONResponse struct {
+	// Mapping Задаётся либо user_id, либо team_name
+	Mapping HandleMapping `json:"mapping"`
+}
+
+func (response AddHandleMapping201JSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHandleMapping400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddHandleMapping400JSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHandleMapping401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddHandleMapping401JSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHandleMapping403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddHandleMapping403JSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHandleMapping404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddHandleMapping404JSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHandleMappingdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response AddHandleMappingdefaultJSONResponse) VisitAddHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddOwnershipRuleRequestObject struct {
+	Body *AddOwnershipRuleJSONRequestBody
+}
+
+type AddOwnershipRuleResponseObject interface {
+	VisitAddOwnershipRuleResponse(w http.ResponseWriter) error
+}
+
+type AddOwnershipRule201JSONResponse struct {
+	Rule OwnershipRule `json:"rule"`
+}
+
+func (response AddOwnershipRule201JSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOwnershipRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddOwnershipRule400JSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOwnershipRule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddOwnershipRule401JSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOwnershipRule403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddOwnershipRule403JSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOwnershipRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddOwnershipRule404JSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOwnershipRuledefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response AddOwnershipRuledefaultJSONResponse) VisitAddOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteHandleMappingRequestObject struct {
+	Body *DeleteHandleMappingJSONRequestBody
+}
+
+type DeleteHandleMappingResponseObject interface {
+	VisitDeleteHandleMappingResponse(w http.ResponseWriter) error
+}
+
+type DeleteHandleMapping200JSONResponse struct {
+	Deleted string `json:"deleted"`
+}
+
+func (response DeleteHandleMapping200JSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHandleMapping400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteHandleMapping400JSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHandleMapping401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteHandleMapping401JSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHandleMapping403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteHandleMapping403JSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHandleMapping404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteHandleMapping404JSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHandleMappingdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response DeleteHandleMappingdefaultJSONResponse) VisitDeleteHandleMappingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteOwnershipRuleRequestObject struct {
+	Body *DeleteOwnershipRuleJSONRequestBody
+}
+
+type DeleteOwnershipRuleResponseObject interface {
+	VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteOwnershipRule200JSONResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+func (response DeleteOwnershipRule200JSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOwnershipRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteOwnershipRule400JSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOwnershipRule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteOwnershipRule401JSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOwnershipRule403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteOwnershipRule403JSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOwnershipRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteOwnershipRule404JSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOwnershipRuledefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response DeleteOwnershipRuledefaultJSONResponse) VisitDeleteOwnershipRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamRequestObject struct {
+	Params GetTeamParams
+}
+
+type GetTeamResponseObject interface {
+	VisitGetTeamResponse(w http.ResponseWriter) error
+}
+
+type GetTeam200JSONResponse Team
+
+func (response GetTeam200JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeam400JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeam401JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeam403JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTeam404JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetTeamdefaultJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSLARequestObject struct {
+	Params GetTeamSLAParams
+}
+
+type GetTeamSLAResponseObject interface {
+	VisitGetTeamSLAResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSLA200JSONResponse struct {
+	Sla TeamSLA `json:"sla"`
+}
+
+func (response GetTeamSLA200JSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSLA400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamSLA400JSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSLA401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeamSLA401JSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSLA403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeamSLA403JSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSLA404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTeamSLA404JSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSLAdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetTeamSLAdefaultJSONResponse) VisitGetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListHandleMappingsRequestObject struct {
+}
+
+type ListHandleMappingsResponseObject interface {
+	VisitListHandleMappingsResponse(w http.ResponseWriter) error
+}
+
+type ListHandleMappings200JSONResponse struct {
+	Mappings []HandleMapping `json:"mappings"`
+}
+
+func (response ListHandleMappings200JSONResponse) VisitListHandleMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHandleMappings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListHandleMappings401JSONResponse) VisitListHandleMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHandleMappings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListHandleMappings403JSONResponse) VisitListHandleMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHandleMappingsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListHandleMappingsdefaultJSONResponse) VisitListHandleMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportCodeownersRequestObject struct {
+	Params ImportCodeownersParams
+	Body   *ImportCodeownersTextRequestBody
+}
+
+type ImportCodeownersResponseObject interface {
+	VisitImportCodeownersResponse(w http.ResponseWriter) error
+}
+
+type ImportCodeowners200JSONResponse struct {
+	Import CodeownersImportReport `json:"import"`
+}
+
+func (response ImportCodeowners200JSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCodeowners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportCodeowners400JSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCodeowners401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportCodeowners401JSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCodeowners403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportCodeowners403JSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCodeowners404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ImportCodeowners404JSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCodeownersdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ImportCodeownersdefaultJSONResponse) VisitImportCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOwnershipRulesRequestObject struct {
+	Params ListOwnershipRulesParams
+}
+
+type ListOwnershipRulesResponseObject interface {
+	VisitListOwnershipRulesResponse(w http.ResponseWriter) error
+}
+
+type ListOwnershipRules200JSONResponse struct {
+	Rules    []OwnershipRule `json:"rules"`
+	TeamName string          `json:"team_name"`
+}
+
+func (response ListOwnershipRules200JSONResponse) VisitListOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwnershipRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListOwnershipRules400JSONResponse) VisitListOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwnershipRules401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOwnershipRules401JSONResponse) VisitListOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwnershipRules403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListOwnershipRules403JSONResponse) VisitListOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwnershipRulesdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListOwnershipRulesdefaultJSONResponse) VisitListOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamDefaultMaxOpenReviewsRequestObject struct {
+	Body *SetTeamDefaultMaxOpenReviewsJSONRequestBody
+}
+
+type SetTeamDefaultMaxOpenReviewsResponseObject interface {
+	VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type SetTeamDefaultMaxOpenReviews200JSONResponse struct {
+	DefaultMaxOpenReviews *int   `json:"default_max_open_reviews"`
+	TeamName              string `json:"team_name"`
+}
+
+func (response SetTeamDefaultMaxOpenReviews200JSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamDefaultMaxOpenReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamDefaultMaxOpenReviews400JSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamDefaultMaxOpenReviews401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetTeamDefaultMaxOpenReviews401JSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamDefaultMaxOpenReviews403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetTeamDefaultMaxOpenReviews403JSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamDefaultMaxOpenReviews404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetTeamDefaultMaxOpenReviews404JSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamDefaultMaxOpenReviewsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetTeamDefaultMaxOpenReviewsdefaultJSONResponse) VisitSetTeamDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamNotificationWebhookRequestObject struct {
+	Body *SetTeamNotificationWebhookJSONRequestBody
+}
+
+type SetTeamNotificationWebhookResponseObject interface {
+	VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error
+}
+
+type SetTeamNotificationWebhook200JSONResponse struct {
+	Enabled  bool   `json:"enabled"`
+	TeamName string `json:"team_name"`
+}
+
+func (response SetTeamNotificationWebhook200JSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamNotificationWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamNotificationWebhook400JSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamNotificationWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetTeamNotificationWebhook401JSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamNotificationWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetTeamNotificationWebhook403JSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamNotificationWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetTeamNotificationWebhook404JSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamNotificationWebhookdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetTeamNotificationWebhookdefaultJSONResponse) VisitSetTeamNotificationWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamSLARequestObject struct {
+	Body *SetTeamSLAJSONRequestBody
+}
+
+type SetTeamSLAResponseObject interface {
+	VisitSetTeamSLAResponse(w http.ResponseWriter) error
+}
+
+type SetTeamSLA200JSONResponse struct {
+	Sla TeamSLA `json:"sla"`
+}
+
+func (response SetTeamSLA200JSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSLA400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamSLA400JSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSLA401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetTeamSLA401JSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSLA403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetTeamSLA403JSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSLA404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetTeamSLA404JSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSLAdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetTeamSLAdefaultJSONResponse) VisitSetTeamSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddUserUnavailabilityRequestObject struct {
+	Body *AddUserUnavailabilityJSONRequestBody
+}
+
+type AddUserUnavailabilityResponseObject interface {
+	VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type AddUserUnavailability201JSONResponse struct {
+	Availability Unavailability `json:"availability"`
+}
+
+func (response AddUserUnavailability201JSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserUnavailability400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddUserUnavailability400JSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserUnavailability401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddUserUnavailability401JSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserUnavailability403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddUserUnavailability403JSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserUnavailability404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddUserUnavailability404JSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserUnavailabilitydefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response AddUserUnavailabilitydefaultJSONResponse) VisitAddUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteUserUnavailabilityRequestObject struct {
+	Body *DeleteUserUnavailabilityJSONRequestBody
+}
+
+type DeleteUserUnavailabilityResponseObject interface {
+	VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserUnavailability200JSONResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+func (response DeleteUserUnavailability200JSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserUnavailability400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteUserUnavailability400JSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserUnavailability401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteUserUnavailability401JSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserUnavailability403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteUserUnavailability403JSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserUnavailability404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteUserUnavailability404JSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserUnavailabilitydefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response DeleteUserUnavailabilitydefaultJSONResponse) VisitDeleteUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListUserUnavailabilityRequestObject struct {
+	Params ListUserUnavailabilityParams
+}
+
+type ListUserUnavailabilityResponseObject interface {
+	VisitListUserUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type ListUserUnavailability200JSONResponse struct {
+	Availability []Unavailability `json:"availability"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+func (response ListUserUnavailability200JSONResponse) VisitListUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserUnavailability400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListUserUnavailability400JSONResponse) VisitListUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserUnavailability401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUserUnavailability401JSONResponse) VisitListUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserUnavailability403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListUserUnavailability403JSONResponse) VisitListUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserUnavailabilitydefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ListUserUnavailabilitydefaultJSONResponse) VisitListUserUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserReviewRequestObject struct {
+	Params GetUserReviewParams
+}
+
+type GetUserReviewResponseObject interface {
+	VisitGetUserReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUserReview200JSONResponse struct {
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+func (response GetUserReview200JSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserReview400JSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReview401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserReview401JSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReview403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUserReview403JSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReview404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUserReview404JSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetUserReviewdefaultJSONResponse) VisitGetUserReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserScheduleRequestObject struct {
+	Params GetUserScheduleParams
+}
+
+type GetUserScheduleResponseObject interface {
+	VisitGetUserScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetUserSchedule200JSONResponse struct {
+	Schedule WorkSchedule `json:"schedule"`
+}
+
+func (response GetUserSchedule200JSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserSchedule400JSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSchedule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserSchedule401JSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSchedule403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUserSchedule403JSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUserSchedule404JSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserScheduledefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetUserScheduledefaultJSONResponse) VisitGetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserEmailRequestObject struct {
+	Body *SetUserEmailJSONRequestBody
+}
+
+type SetUserEmailResponseObject interface {
+	VisitSetUserEmailResponse(w http.ResponseWriter) error
+}
+
+type SetUserEmail200JSONResponse struct {
+	Email string `json:"email"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+func (response SetUserEmail200JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserEmail400JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUserEmail401JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserEmail403JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUserEmail404JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmaildefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetUserEmaildefaultJSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserIsActiveRequestObject struct {
+	Body *SetUserIsActiveJSONRequestBody
+}
+
+type SetUserIsActiveResponseObject interface {
+	VisitSetUserIsActiveResponse(w http.ResponseWriter) error
+}
+
+type SetUserIsActive200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserIsActive200JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserIsActive400JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUserIsActive401JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserIsActive403JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUserIsActive404JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActivedefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetUserIsActivedefaultJSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserMaxOpenReviewsRequestObject struct {
+	Body *SetUserMaxOpenReviewsJSONRequestBody
+}
+
+type SetUserMaxOpenReviewsResponseObject interface {
+	VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type SetUserMaxOpenReviews200JSONResponse struct {
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+func (response SetUserMaxOpenReviews200JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserMaxOpenReviews400JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUserMaxOpenReviews401JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserMaxOpenReviews403JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUserMaxOpenReviews404JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviewsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetUserMaxOpenReviewsdefaultJSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserNotificationsRequestObject struct {
+	Body *SetUserNotificationsJSONRequestBody
+}
+
+type SetUserNotificationsResponseObject interface {
+	VisitSetUserNotificationsResponse(w http.ResponseWriter) error
+}
+
+type SetUserNotifications200JSONResponse struct {
+	NotificationsEnabled bool `json:"notifications_enabled"`
+
+	// UserId ID пользователя, "u3" или "3"
+	UserId UserId `json:"user_id"`
+}
+
+func (response SetUserNotifications200JSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserNotifications400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserNotifications400JSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserNotifications401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUserNotifications401JSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserNotifications403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserNotifications403JSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserNotifications404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUserNotifications404JSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserNotificationsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetUserNotificationsdefaultJSONResponse) VisitSetUserNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserScheduleRequestObject struct {
+	Body *SetUserScheduleJSONRequestBody
+}
+
+type SetUserScheduleResponseObject interface {
+	VisitSetUserScheduleResponse(w http.ResponseWriter) error
+}
+
+type SetUserSchedule200JSONResponse struct {
+	Schedule WorkSchedule `json:"schedule"`
+}
+
+func (response SetUserSchedule200JSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserSchedule400JSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSchedule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUserSchedule401JSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSchedule403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserSchedule403JSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUserSchedule404JSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserScheduledefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SetUserScheduledefaultJSONResponse) VisitSetUserScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GitlabWebhookRequestObject struct {
+	Params GitlabWebhookParams
+	Body   *GitlabWebhookJSONRequestBody
+}
+
+type GitlabWebhookResponseObject interface {
+	VisitGitlabWebhookResponse(w http.ResponseWriter) error
+}
+
+type GitlabWebhook200JSONResponse WebhookResult
+
+func (response GitlabWebhook200JSONResponse) VisitGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GitlabWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GitlabWebhook400JSONResponse) VisitGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GitlabWebhook401JSONResponse ErrorResponse
+
+func (response GitlabWebhook401JSONResponse) VisitGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GitlabWebhook404JSONResponse ErrorResponse
+
+func (response GitlabWebhook404JSONResponse) VisitGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GitlabWebhookdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GitlabWebhookdefaultJSONResponse) VisitGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys/create)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Все API-ключи, включая отозванные (без самих ключей)
+	// (GET /admin/apiKeys/list)
+	ListAPIKeys(ctx context.Context, request ListAPIKeysRequestObject) (ListAPIKeysResponseObject, error)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error)
+	// Выгрузить команды, пользователей, членство, PR и назначения
+	// (GET /admin/export)
+	ExportData(ctx context.Context, request ExportDataRequestObject) (ExportDataResponseObject, error)
+	// Загрузить данные в формате экспорта (upsert в одной транзакции)
+	// (POST /admin/import)
+	ImportData(ctx context.Context, request ImportDataRequestObject) (ImportDataResponseObject, error)
+	// Текущий уровень логирования
+	// (GET /admin/logLevel)
+	GetLogLevel(ctx context.Context, request GetLogLevelRequestObject) (GetLogLevelResponseObject, error)
+	// Сменить уровень логирования без перезапуска
+	// (POST /admin/logLevel)
+	SetLogLevel(ctx context.Context, request SetLogLevelRequestObject) (SetLogLevelResponseObject, error)
+	// Запрос GraphQL (только чтение) - для ссылок и кэширующих прокси
+	// (GET /graphql)
+	ExecuteGraphQLGet(ctx context.Context, request ExecuteGraphQLGetRequestObject) (ExecuteGraphQLGetResponseObject, error)
+	// Запрос GraphQL (только чтение)
+	// (POST /graphql)
+	ExecuteGraphQL(ctx context.Context, request ExecuteGraphQLRequestObject) (ExecuteGraphQLResponseObject, error)
+	// Liveness - процесс жив
+	// (GET /health/live)
+	HealthLive(ctx context.Context, request HealthLiveRequestObject) (HealthLiveResponseObject, error)
+	// Readiness - БД доступна, схема актуальна, фоновые задачи работают
+	// (GET /health/ready)
+	HealthReady(ctx context.Context, request HealthReadyRequestObject) (HealthReadyResponseObject, error)
+	// Метрики в текстовом формате Prometheus
+	// (GET /metrics)
+	GetMetrics(ctx context.Context, request GetMetricsRequestObject) (GetMetricsResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды
+	// (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, request CreatePullRequestRequestObject) (CreatePullRequestResponseObject, error)
+	// PR с ревьюверами, командой и историей назначений
+	// (GET /pullRequest/get)
+	GetPullRequest(ctx context.Context, request GetPullRequestRequestObject) (GetPullRequestResponseObject, error)
+	// Список PR с фильтрами, сортировкой и курсорной пагинацией
+	// (GET /pullRequest/list)
+	ListPullRequests(ctx context.Context, request ListPullRequestsRequestObject) (ListPullRequestsResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, request MergePullRequestRequestObject) (MergePullRequestResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignReviewer(ctx context.Context, request ReassignReviewerRequestObject) (ReassignReviewerResponseObject, error)
+	// Открытые PR, у которых ревьюверов меньше, чем нужно
+	// (GET /pullRequest/understaffed)
+	ListUnderstaffedPullRequests(ctx context.Context, request ListUnderstaffedPullRequestsRequestObject) (ListUnderstaffedPullRequestsResponseObject, error)
+	// Команды (фильтр и пагинация)
+	// (GET /scim/v2/Groups)
+	ListScimGroups(ctx context.Context, request ListScimGroupsRequestObject) (ListScimGroupsResponseObject, error)
+	// Создать команду
+	// (POST /scim/v2/Groups)
+	CreateScimGroup(ctx context.Context, request CreateScimGroupRequestObject) (CreateScimGroupResponseObject, error)
+	// Удалить команду (с PR - только очистить состав)
+	// (DELETE /scim/v2/Groups/{id})
+	DeleteScimGroup(ctx context.Context, request DeleteScimGroupRequestObject) (DeleteScimGroupResponseObject, error)
+	// Команда
+	// (GET /scim/v2/Groups/{id})
+	GetScimGroup(ctx context.Context, request GetScimGroupRequestObject) (GetScimGroupResponseObject, error)
+	// Изменить displayName, externalId или состав
+	// (PATCH /scim/v2/Groups/{id})
+	PatchScimGroup(ctx context.Context, request PatchScimGroupRequestObject) (PatchScimGroupResponseObject, error)
+	// Заменить команду и её состав
+	// (PUT /scim/v2/Groups/{id})
+	ReplaceScimGroup(ctx context.Context, request ReplaceScimGroupRequestObject) (ReplaceScimGroupResponseObject, error)
+	// Возможности SCIM-сервера
+	// (GET /scim/v2/ServiceProviderConfig)
+	GetScimServiceProviderConfig(ctx context.Context, request GetScimServiceProviderConfigRequestObject) (GetScimServiceProviderConfigResponseObject, error)
+	// Пользователи (фильтр и пагинация)
+	// (GET /scim/v2/Users)
+	ListScimUsers(ctx context.Context, request ListScimUsersRequestObject) (ListScimUsersResponseObject, error)
+	// Создать пользователя или привязать существующего с тем же именем
+	// (POST /scim/v2/Users)
+	CreateScimUser(ctx context.Context, request CreateScimUserRequestObject) (CreateScimUserResponseObject, error)
+	// Деактивировать пользователя и исключить из команд
+	// (DELETE /scim/v2/Users/{id})
+	DeleteScimUser(ctx context.Context, request DeleteScimUserRequestObject) (DeleteScimUserResponseObject, error)
+	// Пользователь
+	// (GET /scim/v2/Users/{id})
+	GetScimUser(ctx context.Context, request GetScimUserRequestObject) (GetScimUserResponseObject, error)
+	// Изменить active, userName или externalId
+	// (PATCH /scim/v2/Users/{id})
+	PatchScimUser(ctx context.Context, request PatchScimUserRequestObject) (PatchScimUserResponseObject, error)
+	// Заменить пользователя; active false передаёт его открытые ревью
+	// (PUT /scim/v2/Users/{id})
+	ReplaceScimUser(ctx context.Context, request ReplaceScimUserRequestObject) (ReplaceScimUserResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(ctx context.Context, request AddTeamRequestObject) (AddTeamResponseObject, error)
+	// Сопоставить владельца из CODEOWNERS пользователю или команде
+	// (POST /team/addHandleMapping)
+	AddHandleMapping(ctx context.Context, request AddHandleMappingRequestObject) (AddHandleMappingResponseObject, error)
+	// Добавить правило владения путями в конец списка правил команды
+	// (POST /team/addOwnershipRule)
+	AddOwnershipRule(ctx context.Context, request AddOwnershipRuleRequestObject) (AddOwnershipRuleResponseObject, error)
+	// Удалить соответствие владельца CODEOWNERS
+	// (POST /team/deleteHandleMapping)
+	DeleteHandleMapping(ctx context.Context, request DeleteHandleMappingRequestObject) (DeleteHandleMappingResponseObject, error)
+	// Удалить правило владения путями
+	// (POST /team/deleteOwnershipRule)
+	DeleteOwnershipRule(ctx context.Context, request DeleteOwnershipRuleRequestObject) (DeleteOwnershipRuleResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(ctx context.Context, request GetTeamRequestObject) (GetTeamResponseObject, error)
+	// SLA команды
+	// (GET /team/getSLA)
+	GetTeamSLA(ctx context.Context, request GetTeamSLARequestObject) (GetTeamSLAResponseObject, error)
+	// Все соответствия владельцев CODEOWNERS
+	// (GET /team/handleMappings)
+	ListHandleMappings(ctx context.Context, request ListHandleMappingsRequestObject) (ListHandleMappingsResponseObject, error)
+	// Заменить правила команды содержимым файла CODEOWNERS
+	// (POST /team/importCodeowners)
+	ImportCodeowners(ctx context.Context, request ImportCodeownersRequestObject) (ImportCodeownersResponseObject, error)
+	// Правила владения путями команды в порядке применения
+	// (GET /team/ownershipRules)
+	ListOwnershipRules(ctx context.Context, request ListOwnershipRulesRequestObject) (ListOwnershipRulesResponseObject, error)
+	// Лимит открытых ревью на участника команды по умолчанию
+	// (POST /team/setDefaultMaxOpenReviews)
+	SetTeamDefaultMaxOpenReviews(ctx context.Context, request SetTeamDefaultMaxOpenReviewsRequestObject) (SetTeamDefaultMaxOpenReviewsResponseObject, error)
+	// Вебхук уведомлений команды (Slack/Mattermost-совместимый)
+	// (POST /team/setNotificationWebhook)
+	SetTeamNotificationWebhook(ctx context.Context, request SetTeamNotificationWebhookRequestObject) (SetTeamNotificationWebhookResponseObject, error)
+	// SLA первого ответа ревьювера и политика для просроченных ревью
+	// (POST /team/setSLA)
+	SetTeamSLA(ctx context.Context, request SetTeamSLARequestObject) (SetTeamSLAResponseObject, error)
+	// Период отсутствия (отпуск, больничный) - в это время ревью не назначаются
+	// (POST /users/availability/add)
+	AddUserUnavailability(ctx context.Context, request AddUserUnavailabilityRequestObject) (AddUserUnavailabilityResponseObject, error)
+	// Удалить период отсутствия
+	// (POST /users/availability/delete)
+	DeleteUserUnavailability(ctx context.Context, request DeleteUserUnavailabilityRequestObject) (DeleteUserUnavailabilityResponseObject, error)
+	// Периоды отсутствия пользователя
+	// (GET /users/availability/list)
+	ListUserUnavailability(ctx context.Context, request ListUserUnavailabilityRequestObject) (ListUserUnavailabilityResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReview(ctx context.Context, request GetUserReviewRequestObject) (GetUserReviewResponseObject, error)
+	// Рабочее расписание пользователя
+	// (GET /users/getSchedule)
+	GetUserSchedule(ctx context.Context, request GetUserScheduleRequestObject) (GetUserScheduleResponseObject, error)
+	// Адрес для ежедневного дайджеста ревью
+	// (POST /users/setEmail)
+	SetUserEmail(ctx context.Context, request SetUserEmailRequestObject) (SetUserEmailResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(ctx context.Context, request SetUserIsActiveRequestObject) (SetUserIsActiveResponseObject, error)
+	// Личный лимит открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	SetUserMaxOpenReviews(ctx context.Context, request SetUserMaxOpenReviewsRequestObject) (SetUserMaxOpenReviewsResponseObject, error)
+	// Включить или отключить уведомления пользователя в чате
+	// (POST /users/setNotifications)
+	SetUserNotifications(ctx context.Context, request SetUserNotificationsRequestObject) (SetUserNotificationsResponseObject, error)
+	// Рабочее расписание пользователя (для SLA и назначения ревью)
+	// (POST /users/setSchedule)
+	SetUserSchedule(ctx context.Context, request SetUserScheduleRequestObject) (SetUserScheduleResponseObject, error)
+	// Вебхук merge request из GitLab
+	// (POST /webhooks/gitlab)
+	GitlabWebhook(ctx context.Context, request GitlabWebhookRequestObject) (GitlabWebhookResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
+
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAPIKeys operation middleware
+func (sh *strictHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListAPIKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAPIKeys(ctx, request.(ListAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAPIKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAPIKeysResponseObject); ok {
+		if err := validResponse.VisitListAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeAPIKey operation middleware
+func (sh *strictHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request RevokeAPIKeyRequestObject
+
+	var body RevokeAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIKey(ctx, request.(RevokeAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPIKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportData operation middleware
+func (sh *strictHandler) ExportData(w http.ResponseWriter, r *http.Request, params ExportDataParams) {
+	var request ExportDataRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportData(ctx, request.(ExportDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportData")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportDataResponseObject); ok {
+		if err := validResponse.VisitExportDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportData operation middleware
+func (sh *strictHandler) ImportData(w http.ResponseWriter, r *http.Request, params ImportDataParams) {
+	var request ImportDataRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ImportDataJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportData(ctx, request.(ImportDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportData")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportDataResponseObject); ok {
+		if err := validResponse.VisitImportDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLogLevel operation middleware
+func (sh *strictHandler) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var request GetLogLevelRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogLevel(ctx, request.(GetLogLevelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogLevel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogLevelResponseObject); ok {
+		if err := validResponse.VisitGetLogLevelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetLogLevel operation middleware
+func (sh *strictHandler) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var request SetLogLevelRequestObject
+
+	var body SetLogLevelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetLogLevel(ctx, request.(SetLogLevelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetLogLevel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetLogLevelResponseObject); ok {
+		if err := validResponse.VisitSetLogLevelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExecuteGraphQLGet operation middleware
+func (sh *strictHandler) ExecuteGraphQLGet(w http.ResponseWriter, r *http.Request, params ExecuteGraphQLGetParams) {
+	var request ExecuteGraphQLGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecuteGraphQLGet(ctx, request.(ExecuteGraphQLGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecuteGraphQLGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExecuteGraphQLGetResponseObject); ok {
+		if err := validResponse.VisitExecuteGraphQLGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExecuteGraphQL operation middleware
+func (sh *strictHandler) ExecuteGraphQL(w http.ResponseWriter, r *http.Request) {
+	var request ExecuteGraphQLRequestObject
+
+	var body ExecuteGraphQLJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecuteGraphQL(ctx, request.(ExecuteGraphQLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecuteGraphQL")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExecuteGraphQLResponseObject); ok {
+		if err := validResponse.VisitExecuteGraphQLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HealthLive operation middleware
+func (sh *strictHandler) HealthLive(w http.ResponseWriter, r *http.Request) {
+	var request HealthLiveRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HealthLive(ctx, request.(HealthLiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HealthLive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthLiveResponseObject); ok {
+		if err := validResponse.VisitHealthLiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HealthReady operation middleware
+func (sh *strictHandler) HealthReady(w http.ResponseWriter, r *http.Request) {
+	var request HealthReadyRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HealthReady(ctx, request.(HealthReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HealthReady")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthReadyResponseObject); ok {
+		if err := validResponse.VisitHealthReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMetrics operation middleware
+func (sh *strictHandler) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	var request GetMetricsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMetrics(ctx, request.(GetMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMetricsResponseObject); ok {
+		if err := validResponse.VisitGetMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePullRequest operation middleware
+func (sh *strictHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request CreatePullRequestRequestObject
+
+	var body CreatePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePullRequest(ctx, request.(CreatePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePullRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequest operation middleware
+func (sh *strictHandler) GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams) {
+	var request GetPullRequestRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequest(ctx, request.(GetPullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPullRequests operation middleware
+func (sh *strictHandler) ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams) {
+	var request ListPullRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPullRequests(ctx, request.(ListPullRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPullRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPullRequestsResponseObject); ok {
+		if err := validResponse.VisitListPullRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePullRequest operation middleware
+func (sh *strictHandler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request MergePullRequestRequestObject
+
+	var body MergePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePullRequest(ctx, request.(MergePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePullRequestResponseObject); ok {
+		if err := validResponse.VisitMergePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignReviewer operation middleware
+func (sh *strictHandler) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
+	var request ReassignReviewerRequestObject
+
+	var body ReassignReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignReviewer(ctx, request.(ReassignReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignReviewerResponseObject); ok {
+		if err := validResponse.VisitReassignReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUnderstaffedPullRequests operation middleware
+func (sh *strictHandler) ListUnderstaffedPullRequests(w http.ResponseWriter, r *http.Request, params ListUnderstaffedPullRequestsParams) {
+	var request ListUnderstaffedPullRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUnderstaffedPullRequests(ctx, request.(ListUnderstaffedPullRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUnderstaffedPullRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUnderstaffedPullRequestsResponseObject); ok {
+		if err := validResponse.VisitListUnderstaffedPullRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListScimGroups operation middleware
+func (sh *strictHandler) ListScimGroups(w http.ResponseWriter, r *http.Request, params ListScimGroupsParams) {
+	var request ListScimGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListScimGroups(ctx, request.(ListScimGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListScimGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListScimGroupsResponseObject); ok {
+		if err := validResponse.VisitListScimGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateScimGroup operation middleware
+func (sh *strictHandler) CreateScimGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateScimGroupRequestObject
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body CreateScimGroupJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body CreateScimGroupApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateScimGroup(ctx, request.(CreateScimGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateScimGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateScimGroupResponseObject); ok {
+		if err := validResponse.VisitCreateScimGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimGroup operation middleware
+func (sh *strictHandler) DeleteScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request DeleteScimGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimGroup(ctx, request.(DeleteScimGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteScimGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteScimGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimGroup operation middleware
+func (sh *strictHandler) GetScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request GetScimGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimGroup(ctx, request.(GetScimGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimGroupResponseObject); ok {
+		if err := validResponse.VisitGetScimGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimGroup operation middleware
+func (sh *strictHandler) PatchScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request PatchScimGroupRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body PatchScimGroupJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body PatchScimGroupApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimGroup(ctx, request.(PatchScimGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchScimGroupResponseObject); ok {
+		if err := validResponse.VisitPatchScimGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaceScimGroup operation middleware
+func (sh *strictHandler) ReplaceScimGroup(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request ReplaceScimGroupRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ReplaceScimGroupJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body ReplaceScimGroupApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceScimGroup(ctx, request.(ReplaceScimGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceScimGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceScimGroupResponseObject); ok {
+		if err := validResponse.VisitReplaceScimGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimServiceProviderConfig operation middleware
+func (sh *strictHandler) GetScimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetScimServiceProviderConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimServiceProviderConfig(ctx, request.(GetScimServiceProviderConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimServiceProviderConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimServiceProviderConfigResponseObject); ok {
+		if err := validResponse.VisitGetScimServiceProviderConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListScimUsers operation middleware
+func (sh *strictHandler) ListScimUsers(w http.ResponseWriter, r *http.Request, params ListScimUsersParams) {
+	var request ListScimUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListScimUsers(ctx, request.(ListScimUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListScimUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListScimUsersResponseObject); ok {
+		if err := validResponse.VisitListScimUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateScimUser operation middleware
+func (sh *strictHandler) CreateScimUser(w http.ResponseWriter, r *http.Request) {
+	var request CreateScimUserRequestObject
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body CreateScimUserJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body CreateScimUserApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateScimUser(ctx, request.(CreateScimUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateScimUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateScimUserResponseObject); ok {
+		if err := validResponse.VisitCreateScimUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimUser operation middleware
+func (sh *strictHandler) DeleteScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request DeleteScimUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimUser(ctx, request.(DeleteScimUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteScimUserResponseObject); ok {
+		if err := validResponse.VisitDeleteScimUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimUser operation middleware
+func (sh *strictHandler) GetScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request GetScimUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimUser(ctx, request.(GetScimUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimUserResponseObject); ok {
+		if err := validResponse.VisitGetScimUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimUser operation middleware
+func (sh *strictHandler) PatchScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request PatchScimUserRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body PatchScimUserJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body PatchScimUserApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimUser(ctx, request.(PatchScimUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchScimUserResponseObject); ok {
+		if err := validResponse.VisitPatchScimUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaceScimUser operation middleware
+func (sh *strictHandler) ReplaceScimUser(w http.ResponseWriter, r *http.Request, id ScimId) {
+	var request ReplaceScimUserRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ReplaceScimUserJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/scim+json") {
+
+		var body ReplaceScimUserApplicationScimPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceScimUser(ctx, request.(ReplaceScimUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceScimUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceScimUserResponseObject); ok {
+		if err := validResponse.VisitReplaceScimUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddTeam operation middleware
+func (sh *strictHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
+	var request AddTeamRequestObject
+
+	var body AddTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTeam(ctx, request.(AddTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddTeamResponseObject); ok {
+		if err := validResponse.VisitAddTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddHandleMapping operation middleware
+func (sh *strictHandler) AddHandleMapping(w http.ResponseWriter, r *http.Request) {
+	var request AddHandleMappingRequestObject
+
+	var body AddHandleMappingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddHandleMapping(ctx, request.(AddHandleMappingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddHandleMapping")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddHandleMappingResponseObject); ok {
+		if err := validResponse.VisitAddHandleMappingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddOwnershipRule operation middleware
+func (sh *strictHandler) AddOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	var request AddOwnershipRuleRequestObject
+
+	var body AddOwnershipRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddOwnershipRule(ctx, request.(AddOwnershipRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddOwnershipRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddOwnershipRuleResponseObject); ok {
+		if err := validResponse.VisitAddOwnershipRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteHandleMapping operation middleware
+func (sh *strictHandler) DeleteHandleMapping(w http.ResponseWriter, r *http.Request) {
+	var request DeleteHandleMappingRequestObject
+
+	var body DeleteHandleMappingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteHandleMapping(ctx, request.(DeleteHandleMappingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteHandleMapping")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteHandleMappingResponseObject); ok {
+		if err := validResponse.VisitDeleteHandleMappingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOwnershipRule operation middleware
+func (sh *strictHandler) DeleteOwnershipRule(w http.ResponseWriter, r *http.Request) {
+	var request DeleteOwnershipRuleRequestObject
+
+	var body DeleteOwnershipRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOwnershipRule(ctx, request.(DeleteOwnershipRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOwnershipRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOwnershipRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteOwnershipRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeam operation middleware
+func (sh *strictHandler) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	var request GetTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeam(ctx, request.(GetTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamResponseObject); ok {
+		if err := validResponse.VisitGetTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamSLA operation middleware
+func (sh *strictHandler) GetTeamSLA(w http.ResponseWriter, r *http.Request, params GetTeamSLAParams) {
+	var request GetTeamSLARequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSLA(ctx, request.(GetTeamSLARequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSLA")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSLAResponseObject); ok {
+		if err := validResponse.VisitGetTeamSLAResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListHandleMappings operation middleware
+func (sh *strictHandler) ListHandleMappings(w http.ResponseWriter, r *http.Request) {
+	var request ListHandleMappingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHandleMappings(ctx, request.(ListHandleMappingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHandleMappings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListHandleMappingsResponseObject); ok {
+		if err := validResponse.VisitListHandleMappingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportCodeowners operation middleware
+func (sh *strictHandler) ImportCodeowners(w http.ResponseWriter, r *http.Request, params ImportCodeownersParams) {
+	var request ImportCodeownersRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := ImportCodeownersTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportCodeowners(ctx, request.(ImportCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportCodeownersResponseObject); ok {
+		if err := validResponse.VisitImportCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOwnershipRules operation middleware
+func (sh *strictHandler) ListOwnershipRules(w http.ResponseWriter, r *http.Request, params ListOwnershipRulesParams) {
+	var request ListOwnershipRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOwnershipRules(ctx, request.(ListOwnershipRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOwnershipRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOwnershipRulesResponseObject); ok {
+		if err := validResponse.VisitListOwnershipRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamDefaultMaxOpenReviews operation middleware
+func (sh *strictHandler) SetTeamDefaultMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamDefaultMaxOpenReviewsRequestObject
+
+	var body SetTeamDefaultMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamDefaultMaxOpenReviews(ctx, request.(SetTeamDefaultMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamDefaultMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamDefaultMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitSetTeamDefaultMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamNotificationWebhook operation middleware
+func (sh *strictHandler) SetTeamNotificationWebhook(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamNotificationWebhookRequestObject
+
+	var body SetTeamNotificationWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamNotificationWebhook(ctx, request.(SetTeamNotificationWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamNotificationWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamNotificationWebhookResponseObject); ok {
+		if err := validResponse.VisitSetTeamNotificationWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamSLA operation middleware
+func (sh *strictHandler) SetTeamSLA(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamSLARequestObject
+
+	var body SetTeamSLAJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamSLA(ctx, request.(SetTeamSLARequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamSLA")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamSLAResponseObject); ok {
+		if err := validResponse.VisitSetTeamSLAResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddUserUnavailability operation middleware
+func (sh *strictHandler) AddUserUnavailability(w http.ResponseWriter, r *http.Request) {
+	var request AddUserUnavailabilityRequestObject
+
+	var body AddUserUnavailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddUserUnavailability(ctx, request.(AddUserUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddUserUnavailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddUserUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitAddUserUnavailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserUnavailability operation middleware
+func (sh *strictHandler) DeleteUserUnavailability(w http.ResponseWriter, r *http.Request) {
+	var request DeleteUserUnavailabilityRequestObject
+
+	var body DeleteUserUnavailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserUnavailability(ctx, request.(DeleteUserUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserUnavailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitDeleteUserUnavailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserUnavailability operation middleware
+func (sh *strictHandler) ListUserUnavailability(w http.ResponseWriter, r *http.Request, params ListUserUnavailabilityParams) {
+	var request ListUserUnavailabilityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserUnavailability(ctx, request.(ListUserUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserUnavailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitListUserUnavailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserReview operation middleware
+func (sh *strictHandler) GetUserReview(w http.ResponseWriter, r *http.Request, params GetUserReviewParams) {
+	var request GetUserReviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserReview(ctx, request.(GetUserReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserReviewResponseObject); ok {
+		if err := validResponse.VisitGetUserReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserSchedule operation middleware
+func (sh *strictHandler) GetUserSchedule(w http.ResponseWriter, r *http.Request, params GetUserScheduleParams) {
+	var request GetUserScheduleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserSchedule(ctx, request.(GetUserScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserScheduleResponseObject); ok {
+		if err := validResponse.VisitGetUserScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserEmail operation middleware
+func (sh *strictHandler) SetUserEmail(w http.ResponseWriter, r *http.Request) {
+	var request SetUserEmailRequestObject
+
+	var body SetUserEmailJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserEmail(ctx, request.(SetUserEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserEmail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserEmailResponseObject); ok {
+		if err := validResponse.VisitSetUserEmailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserIsActive operation middleware
+func (sh *strictHandler) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+	var request SetUserIsActiveRequestObject
+
+	var body SetUserIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserIsActive(ctx, request.(SetUserIsActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserIsActive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserIsActiveResponseObject); ok {
+		if err := validResponse.VisitSetUserIsActiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserMaxOpenReviews operation middleware
+func (sh *strictHandler) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request SetUserMaxOpenReviewsRequestObject
+
+	var body SetUserMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserMaxOpenReviews(ctx, request.(SetUserMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitSetUserMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserNotifications operation middleware
+func (sh *strictHandler) SetUserNotifications(w http.ResponseWriter, r *http.Request) {
+	var request SetUserNotificationsRequestObject
+
+	var body SetUserNotificationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserNotifications(ctx, request.(SetUserNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserNotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserNotificationsResponseObject); ok {
+		if err := validResponse.VisitSetUserNotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserSchedule operation middleware
+func (sh *strictHandler) SetUserSchedule(w http.ResponseWriter, r *http.Request) {
+	var request SetUserScheduleRequestObject
+
+	var body SetUserScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserSchedule(ctx, request.(SetUserScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserScheduleResponseObject); ok {
+		if err := validResponse.VisitSetUserScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GitlabWebhook operation middleware
+func (sh *strictHandler) GitlabWebhook(w http.ResponseWriter, r *http.Request, params GitlabWebhookParams) {
+	var request GitlabWebhookRequestObject
+
+	request.Params = params
+
+	var body GitlabWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GitlabWebhook(ctx, request.(GitlabWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GitlabWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GitlabWebhookResponseObject); ok {
+		if err := validResponse.VisitGitlabWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+y9fVPc2Jko/lVO6bdVC7tqaPyS/Q3+Y4cBbJMBQwDHmTUsI2gBWrqlHkltm/hSZWA8",
+	"zl5PxndSubWp3J1MJtmqe/+5VW1sxm0M7a9w9I1uPc85RzqSjtTqpsFjh6TKQ3fr5ZznPO+vD7U1p1Z3",
+	"bNP2PW30oVY3XKNm+qaLnxbWrNq407B9+FAxvTXXqvuWY2ujGv0zbdJX9JgeBo9IsBvsBY9ok57QVvBV",
+	"8FQn9IQeEvqctumb4OvgN/SQXC2XNV2z4N4vGqa7remabdRMbVRbwzfomre2adYM9qp1o1H1tdGRclnX",
+	"/O06XGbZvrlhutrOjo4Lu25VfdNVrOwv7K30iLbJ50uNcvnymuH7Lv5lEvMLsqSxb+8Z1YbJvl7SPicD",
+	"Dc90bxk1UycVy6tXjW32wXzgm65tVKcqgxk7WGdLkbfAF+35rmVvhGueqqTXa1VI8IgeBrvBfvAo2KVN",
+	"sjA+NUMGgie0FezSN7RND2ibviZTE4S+5Xt7hd82gz16SN8Ezwht0Te0RWDP9BgP4mXwNFxu3fA3o9Va",
+	"FU3XXPOLhuWaFW3Udxtm55Uv+IbrT9kV84EC4j+QkWsEcIGe4Gm34PBf0RPaDJ7Al7QVPAMkgR3t0Wbw",
+	"TbAX7AbPyEgGPL3oZWq0UCLFomnU4MR+gQ9Lr/KvsBB6RJsIwRPahlW26HHwLAG3jFX5plFbwb+7A99t",
+	"z3SnKuGqVI8GzFvpcC5/55rr2qj2/w1HFDvMfvWG2Su0HXida3p1x/ZMpOBPjMq8+UXD9JCE1xzbNxk1",
+	"G/V61VozADbD/+YBgB5q5gOjVq+a+KfrOi67pQIL/GRsYmV+8he3JxcWNV2rmL5hVT1t9O5Dbd0yqxXA",
+	"sUa1uuKydwko1UzPMzbg/lrD84nt+GTVJKtVw97SdvToXqPhbzocANE9ABTSuHIJ71t3GnZF21mWL0i9",
+	"cpSk3nONhA8fJaon7hSF8SSAZJ4Dl4E6gWD/QZv0bfCItoNdxgCDXdqm7WCPHtBDRPk9ehDsw99ADY/p",
+	"IT1mOIi0e0IPAQ+DR8AO6BHS9om2o2uT0WHknl+/9vE97uEJI+M20vNzekRbZIAekGAPSIWE3Ak5O5m6",
+	"tTg5f2tsehDWe91xV61KxbTPcc1/ZoyRCx5gk6+Qbb4JniG86UHwlHFPZEd7cGmbvgXxRZvBV7QVfANL",
+	"v+X41xEvzm/lf6LPg//ODpyv/oQ26Wv6Uhw/sN9OKOCtWbV/7G5l0WOVqwqPXcijK+WyTq6Ur8A/H5GG",
+	"bX3RMG3T83RyZeQyKREmi2g7LvaRIA5xM29oUwdkQRy5bTO6tH5tniewv2PEtx88Cb4N9uhJ8DR4TOhL",
+	"5Pz4QZdE6Zvgm+DJMCL8ERwGI1HYymtGyyjOYGN4UiEnQc47Njf1qYkMv+46ddP1LcaR11zT8M3KioF7",
+	"XXfcGvylVQzfLPkWss2ECNFBYo8+TIk8IT0epm9wzXvOVpcvcR3O+u1GTRu9qxmVmmVrurbq+CiXjIrp",
+	"asu6QsBFMusuUy6EkIQn6vKOo9ud1X8z13x475jnWRt2zbT9yXscA+IQM9bY6UVLG1tYmLpxa3JC07Xb",
+	"t8IPy4pNdQUA0/Di7xmfnxxbxNfMT0rvHJuYwP9+Mjb+6fWp6WlN16Zm5mbnF5VLcM17lnmfyfeCYlxH",
+	"2ZdxugmAy4+X7tMF2MJ9ITBUB/BJo7oVHQJCvFKx4GajOiedxLpR9Uw9eTh4Y5eoFhPcWcidgJtC4ZPB",
+	"kHxk/P6sbU8YvtFxwwkW8l8gokFZBEbyW3oU7IJcCR6BZkuAcbTocfTNNVDbS5G4bGpqCNaEBWb5Zs3r",
+	"hCiJI9sJt2e4rrENn2tmbdV0u3viDN6jepoM3e6eOdeoVoUGqngw6NTdPRC0fNWTAPO7exJQW/pJOxmo",
+	"woHTHXVEJoOKSwutvzN2y6ZHw8vHaRniXdJyqIYrCbIXyVUz3Y2zYA6eb/gNL23kzc5N3hISfGZy/sbk",
+	"xDVQQfZRXreZNpIg0UNSInCbai355+dbfrUAh06zJnajHrN75CPmu8s6YSSB/iFiJq5lvR8Jp7v3W94K",
+	"yKN78vtXHadqGnYHSuhGFkZmtCQHo1er9jPuVEznvm263lSt7rj+vAn/ppUQC381Kytuo8q+SS+07jqr",
+	"VbMLfsZeOW3ZJlfE1Qwyh4PYW7Zz317ZNOxK1Yy/OHVxis9lcZjEXtOvkbaqgiluZgJ9BAr9Fx0KkY4V",
+	"9yzMjX02PTs2sbI4O7syPTZ/Y1LTtcXJsZmVyV9NLSwuwBXzsb8ZkWu6dmt2cUXS0W7NroyP3ZqYmhhb",
+	"nOS/Xp+9fYurjLcXb87OT/0LXnl9dv6TqYkJZADCjFUqcqHXI+VZ+j364KSdkBJjNW36HK2D4+ApGqUE",
+	"vQFoDIELKnhKj4VP7xn8+SryIKCmUAiLroMXJROBQndJJ+LBg4muzzzY0L7K9xjJEA/XoLmm5zTcNTPh",
+	"gYmjSPiojrYeR7LkVtgDVDuQYJXCTO6Neph2h+wzf8GROKtvyMCS0LDujiwPcb6zpA3qoUfxLW2iS/wY",
+	"XT+PaFOIpSVt1alsL2maHoFP8TC1NA3PMrq10mDmsukR8ZRyzlMSoGKbzj/2G5Y/bazOgCjnikVoqSUd",
+	"wYDxwdNgj/mODughfR48DvbRjcAeM0Ton+hb1IabQAHMoST89YIYdHTJwk/Bo+ApPWQK9B5tcucZ+m3A",
+	"/t69BjS1iz8JmjpcsgcQ3sFj/lIu+IPd4Cl9wx9Cj9EH/IK2B8ED0A7VhBYjV1QTXsA1eOujYF/4rZfs",
+	"lBLPgLVi+L5rrTZ89mWWGZu2UmXFK9SSLNv/2RVNVwgaK08t6k5X0bWGW1UzhxQe8E1uWXZF+aS66+Cl",
+	"aeFZdGMQqFi5b/mbKIq8urFmFlxbg+skPb44X8dIvC31ja49KG04Jf7lhuVXjdWhNL1Il5WYjMUVQ3Bm",
+	"VKu7JWGxljzTvWetmcOWzSJPw+yR+OYbrlHf/MV0Bg+DWJXtWY7tZStoD1NuMWDZpCQLMD306OrkF7cn",
+	"5z9DiTw+OzM3Pfkr+auJyck5TXEeVYd58IorQ3xj0/zG7mSZzsEYvauTwpPL8BJLUSgx1UbNVtNg1bLN",
+	"AiYdXqaLJ+WsQrLl8vz0nHGSgQgFgIcFe0P07dAgujPhQnoS7AVPCX92gi8G3zDmClKrBTpMG5zlXXLH",
+	"NG+smy7C8RYnMLtRrRqrVVPEuFJn+YWIldUse9q0N/xNOeYXXXfPcC14UAdkz3ifRM7ywbCX5x5IpADF",
+	"d1rh7qT4C3d0ppB0TQoZOp2K/d1EzXzGqNcBNF16tCBu9ZI2g2/ZARLElOe0TbgSoUffyGZCfO/MNkhj",
+	"6cfwEJ187Lgbw3C3UIPMmmFV4cMrMj47MTl759bkPOj0HY68sEelULg0fvJ8C6qjv2kaVX9zfNNc21Ic",
+	"e2gYdOBRzpbK/E2swtlSroDZiuObhr1hFvGRM981mDtzaAMpvePr6gwKDGQiW/gaGQsP9MFhsSi/COrD",
+	"Q1bNdcc1Oz3lJW0r7icD9CVyLbZIjAyZtm/520r4bZnbne0Yfj+7WnKDs83mgBbSULzMgE2Gb8JewxPJ",
+	"+rleybo3aX3xt0T3yA/PXnXkPEgtPEsQdWETciGVJyuF28TDzIwU8HAD3XpEOJYrNICKu73iNmy1F8lr",
+	"1GqGu53N/gq9m6FBOnT4A4sZoryFYDja85i18+8oBiEHCoz4AfRp68g8PV3YZDqJ+dB1Irn9B7VOMkls",
+	"O9qjHoJWdSjTzsa0ec9UuF+q4mvBJyrmamMDM17WHU3X7huurXGBpelapW7Y1pqma+K/64ZvVDuHAdlr",
+	"VEubRWfbplWfb1TNLI09jbLoo1tJhws6eLlg4T4o0cpr645nCb6Z1K3Q8nyGqs8RU5OaYHCCmnSNYBbR",
+	"EaEHkuzSmQ72nB7SH+lLYWSG3JO+RLbHk0IOwD9ADyBETg+VFkkhQVecsKLQYq5WnHJFhyCKICm9PX4u",
+	"quNORCMygofC8lE41/i7WFaEnFPGYveYXXAQfB18g0k24HI7IAPloaFLejwRoSRLsoXpMTI2AdbOL6cm",
+	"70zOD2r66cCYsOGLPYLz/LHs4EhHTZnFV07zBEW4JW/10nlOVVL3Z+JrFK8p+PAFdgOK2Irper6xvm5W",
+	"lHmo6fOXUhEPdYL4ckzoSbBPfwRWrekp4dExZKNKb5PDN3yDugqnO9DFRORXNqrV2XVMqysIJkyjSyji",
+	"luc7PNWwCEon8y8UuB0jz0IPned3dBvSyIlKRIvQwz2mIbsch60q4XZqgszN62QJXC4j5fLIkha5Ztnn",
+	"mGuWXyUxwFHtXwfqbmnwn++OlD5avlsufbT8D3+n8tdKC5kzVGq7bT7wV9Yarsf0t1ziLA783Jh7DqZ3",
+	"xNSFTWVsrAfW9xPnOv1iB53gGa5PaGM8EM2jWiqbbd40KpZtel62G2INzFSvVzVYNnVVrgYvtWhnC40V",
+	"455hMVnTUT0MuSVfqgpOIQvJVhu6SSvo0jVw6rCzvEjV9iD9cqzhb4K5ytyMC7AElVdJ5l4KLM9Ef/ZF",
+	"Rx4Lv4Y5e/LLspYNOQDjjr1ubaQXWzMezAp/X0aYvGY8mDO2q45RWbB+nWGheo06i0EXcJhE1+qJ16fe",
+	"lbWjDAu6Esaw06wlyvQsbotAlu4iPxRBPJZ9z6haleuihIR/Xti2feNB9PmXUKuCZCbybpXcISsv5ubi",
+	"4lyJhcmCPfDkipqdNpY+vO4YJxT7lTQdDp0smLId5eAJ8xl4fUcA8dyshd1wnUZdcdhRzY/yMKMyIOXP",
+	"lvrrbvPwYIHz5ro6+uEXSuqeget6Q9HMQ0fuJkMo2hlfWC60py2V+TfPkwG6gw47PgV88AlzpjsXd23J",
+	"SNUT0cYqn9IP9R3fqObgciZMYzfq8aKn2F50CVK5YM4OFf2F5+bPzS4sDs/dXiTDeIN3jeW0t7Ba4hAZ",
+	"BEbQCfKJR+D1x+yHp8XDPaeko74RzE4GpGY4GSX8YlKsT5HCzcCf5N2YCKdrDCM7Kj2xp0QR0swjnTP8",
+	"tc1ZBady6ukTNioVnbhmvWqsmcKccc2ac88kA+gjeYGe+L3gES8yOaBN+iOYyYOanh1NVYTcQA6NPkxu",
+	"zqnn7yPTBxTXFgofuQCOUsqelu9Ja8raFKBclgjJBVzaGO2uqLOjpGYvylr3AkstmHOde1YlWzwbCs20",
+	"uwNS6raK01ptVLeKPE9SOneE83vO8Lz7jlspcv9CqCQAD/KNja5vWg8LjjvdFlN9GDWtbXb9vt6kFbfQ",
+	"u3hTpoBiy+ZHpEdVzgng85dyqOoZuJOJkLKiF0fC3nTArBfdVmYI5WUln1KSbaCE7Yfml6lbnrlCyEza",
+	"W4XM4IS2GN6YVBw5yEMAdVAg4dz6qT+qyz7+xtVHAEo32iNc34XyOGCzTgqQ8+HpZGhoaLC4QhmRaHxZ",
+	"nFKvRZXTUbroVGVOndskjN5gH3J4r0M+zJI2OEQg4EeCfXqMsvgJ72XxDYGoSQFaz6eSFMh7qOCQ9GJ1",
+	"gAyKWmmTnQKGqDESEpZDH3DoPJPgXIhmYK1RZVjNeDDF7hopl8tpdI8592vGA5HLc+nq1Q65PTmuf7H3",
+	"5QxQ9lSbVbwkpXt/4Wk23kMRC8BgYXqsSwCsW67nr4h2ESubTkOFXvQHesT1U0jQxjj4c5Z2COFXRDlE",
+	"tVQktkkYU2BV+U3Cq+2hEP81idoisJwvq9aoycCRk4KdqrW2HWsAok0ujI9Ns/ymxHL/Nyv04gXn+PZg",
+	"l/ECyNFgGZM8fkyPpVVremjXSU+Xo8RSHbDS+RbH/R5xXXkqqkO/LRzuVpXnS8WP17QrXl+qzKO6aJW7",
+	"0fW9s/TDq/ITIgqJ3q+H+1XCSp0dns8B+ppqeOp4QqxAK5cb8Fd2Y2pCNLRxWQ6EXk5EQRuX4wHQRsfg",
+	"5x1zddNxtrIyw04bAYywUpG5gxH/YJ/l2kgFKawm6y3mbAEPaIcJiNaG7bhmRekMSUe8omw9loCBoLI8",
+	"VMT4kwoHwVRHeMdxt8BwqihzpIC0Vn7t2Cp3wtitMZ3lyqD2wxt0TTbgAcMzjrfm3I+da/KX0+P5fcfd",
+	"WqkY2wpZMrUwS0pkhKGh6KXBmjG16BGoheSfwDMFaTpHrCkWS+Sgh7K2UjMeMHHxT51ER1I7wbWZrHwl",
+	"gsHIR6Plchy9Ea8fXtoZFX8oURwfhzxIEXG5OTozg5nT7WTPqQMUOseogkmrKPe0ikzeEWFJbKESEOSz",
+	"WlalIHrmWsO1/G3J/zNWtz41t8G5k97y2NxUSbRNIQNYknVEfxR018JTPmaJcSzP/ICM8SYw6Cogn5iG",
+	"a7qgjWM7H6xBwDYkOll1fJ3wJiS8Pdem+MS4qvarEizgU8z6FQePq8W6ZXy0WPcqfrouBNbP7yymFImf",
+	"31nkGX0vo3I1VBpeh71hsBMSprD//M6nC9fIWtWwah7xGqtkIIfbgu1DeHMUjwd9+ZKipW/6fp21sMHM",
+	"zDSf+wOraQ+ecOph/l0AM3jrGVeDsB8Zm5saZasMfhvssZI3EnyJTYbegF72gqVkJw0xgsYQU6RavOLj",
+	"BPYQPAq+ZE1woko8+Le5ZA98XjO2TOLUTduoW58P6rzs9YQz5Agd2uymyB6JVb/yEpOod9ZT1q5JOgdo",
+	"3PMKlscSzGPNnOjroSWbm63N2INlq0hGSjQPVeZs8JifI+yYDCBfamPfLmiLpEeVNC11vePgko07oS/C",
+	"DomofwZPgRH+J23Rb4XU/fxBqWY8KEF5aGl12ze9z5Pbal0r1GaJlLAnU6qYemjJ/vxBCTDP+xyakjxi",
+	"VJYotjxmSv4rZMC/QdxoJhYSPLvGCDN9aRv4227w7RBWSfKyQ21unoh8DhIlnBHujSYDi6bnk0XD29LJ",
+	"daNaJZfKl66CLL5nuh7D95GhS0NlzARmuKWNapeHykNcLdlE5jSMaxpmVO8NMykNP9Qd5tcIS5FAP9LG",
+	"8XfeqImxUdPzP3Eq2901yxN9LK0S75XkVJkq6Wux7nJd2GaFrIh+9WyS2zUp5MBOsithssngpfJIVy28",
+	"4ls16tYKL+zITVNkx1S0CkQ8lV2u3lWCof6RSy7WKk7oiEOE/gAFcSHTZ+zgCNH+KYbRQnkG3LJFTzhR",
+	"QMrxAaHf0t+T4HFI/OzSWNFz8Dj4bfAb2NqVcjkLDiHEh6WejnjLSOdbYt3W8KbLnW+K2vjt6JHp3eku",
+	"0VJOKsfQ6O84RFFAoU0u6wqarvnGhoedvRCBl6FSFtlUhNTL8MgEgVe5W3jDVFA3+IwZ0nhaCmHLp0fY",
+	"4l7nCHVzo47hc7vBVtp6j5Bglx7GDh5Fz0H4qYk0hKTxih4IOU8PyQBWVbwKS1NBJoubDunrwZ4RiPXJ",
+	"y5YQ8/h7jxKiJ8bPLK08qybtEemNZ5+GBHJ6tkHfwQLxOtGiDS/vjj3HMOSnzTWvlK90viPsOXpqCvtT",
+	"BJnTM1nzgehQwNlrwj5amL2FEg6pOl4HxxTel0y3ZuXjRxhbwfJzUJ+vkfGFX5KSuKyZeEDwNRlgdZyD",
+	"Q5qeIMpJXBi27tNjDcvvqpsrc7+kspO09m+sNaJQoPjHNe+eUm16qGre+gzsi6gRqLC9xhd+mdFIOqxQ",
+	"jRYk3s8KqUQ3OznJru5G1SWsWaBigcunpPJOLfMQ6BjRMh/4wwCl2O3J5aSJGBSBF2Bk0legQmk6t+Jx",
+	"teNsmaUJy8upzPsD9teR7NcSYag6BPsJPZjY4Z1BGv82h2C53XTu3vnAFTJxDrwbcizLSM9wXoC4xYoq",
+	"RHXeBbetk7l5QlvpGr1W8KxrviN1RnGU0Wi5ASer6iMCcyC1jgxwFPhnRviDoySfV+nFedGSjU03WmEs",
+	"CxvzSz2yD2O+BcbwnkUGgrD+X9D2EOFFvcId8oK2xcWxBoWhI+QIuXpochD+nCU7xSFZJbOaQ6a95aqw",
+	"twKuWbMP0tz1A2GmulqcSLXYaXnCdbqU8rPcu/LYE0+WAje8h4cIJelh8GjJHtHHqtaaqQMnXLJz51f0",
+	"XaHsXInPI0fq7uR7rBofiPa5orFE8DiM7AgyAwJH7xk0+Q6esDDxc0wOaeEfYF5wBxrYHYMfskEOvTkS",
+	"/P+lZHBBOP/LiM3Sw3Sn44FG3TNdHy7ljBNYYTgHBlyuR8xnmWGexV2d2ujly1evXrly+VIH8VCVWhso",
+	"7f4bph+2PzhDHA3focLPvwoHNwSvWAwI8q/Zl82od8p7gSt/weEEIBFbcML7RfZWTOjrWSI+Ab+kHMU8",
+	"DvC/7Qv5wER78Fis54WoBdeJcmYFloLzXgyv5P7/qQekogdpg2QhgXE9upF5aw7ekaNXz3HY4CMOUnyo",
+	"TiCWpBNo8qET7PGByXhar+08zlcs5JLcd3A2wdPCGPoBM/cfuCxkjL0QOAj3sHG99pAj/X6wy820ojr8",
+	"BoTDvsjmzpMPzLWGb/Ko2Q3TL2bDi4/Z1luHfCv1Y+Ot6XLHOKn8HyXajoa3ZOinUY+6vMefpeGebFun",
+	"Hp9zCOwU44lYI3otPvknNj5HNO9CO4gn0D3l4T9Ms3mD9ksL2e4BC4PwDngfuEolpj+JoPBAPM7zJNgT",
+	"QBokJSG7gl2eo4ydhqDeB8JBYSz+35m/+y3PW96lLYkcp8D3KtUqSVQZJkuIYCAvp5fFbh5tnkaUMRoY",
+	"1R5iw0CPPCRADaIXFnlIrAr7Zgf+rxWewZVoiXnO8ueCkH6KhJRLDUkj42dXr17+WUEyAXG2ic0whqs8",
+	"SVQp0ljDjGnrntmbvSHRjcg01JytOFEkCqPChMQeMwwViPtD1FIbsnRa9CCWAqaN3l2WTwm2a5ueF3a6",
+	"D77CnJnw5uhUGHziAAWob3eA6Dxec4b0nG6l0hEwL3j06SCZPoSaFhq/wZeQjgXHd7V8ucBapeOPGrd4",
+	"mw2/4tzHC6Lu+fCtb9kbBH9i/UTRCNiRslRjnVh29LODBTo6I4CohwPKTdembixOzs8M5uJVuA5SYqkT",
+	"9CV3w+7Tt+Cd1aPRiU2CTgb4SeRXws9fYnIpswYOmfn2Ej3Srah6gU8hzcLSmum71pqXGfyi3zJtuRns",
+	"M06EeQ1fsvGizOtBSrGlJzPAWvHMEXQ7C0Wdj448hIemrc0bpj/Dl9eRNtAlWK8alt1toOY/+dgC2FEr",
+	"98Til3JzGzTiXYYYDCPi3qQ516mZ/qbZ8LJOoB7leyvyuBQNvZhk5W2MYxMChgj9n4iCrci8ecmHD/AG",
+	"qyvrVtXEftnCn3DCtK6wgCXWABKSFt4gWnEJvmSjsYQNQCGgEOzyt7TUDQqhMA7uhpU+BnRECyx4GqUQ",
+	"SW94Qw+Dr4YIzi5kkYlDMUqUqYdYcScKvHgAbMmWAzosJbBcjs0owbIYntl4HI1J5Q9grZ7h4wHhDY5V",
+	"oQaWQyd3HDuF2mhEjcS0xkhYacyPh3XL4R3xPdNw1zaHLSh4HNpwQMYpeoJpY5UKYZeKEgp4+EivzpVe",
+	"mjzGt/AwlsYuLNYr5Y9+puruGCu2K+fPZQtLVdIjL6URWPSQB+pYO+cwTh+ZGM9ZfimK8K7q2CQAP0wn",
+	"b0i4qPNXh0mnLIoG9PscHRPRhOgk9QRPh0gyTsQY/2hWuvXXLI/5R6C2JRt8eAwSwTNG2SwdGB0dQsPk",
+	"CUbSktlgUJ7MHNJasB+7LNhHPoSwbccyctETA9HD/ws3EuVo72T8tSkVbypLduAXntGNqdVwNcM7pNMu",
+	"cog6dbMT53p2yaESE6i7Wa1h72qNS5oOpUjLuoJXRN1UNUgfLo2US5euLGJZxWi5/C+aouug3NyxE/cI",
+	"9Svs0beTo57X3a46NCYPwy2ksM/Nx7zXH7Y/k28TCZanGnAi5CoFhtWRhhNZCGFwq00uZchiwYviPVa4",
+	"UiKdVNLBAgZjWlnh+mJWaCouKQt4PlWDTXsZEZ8ooEs7NCcXjQ0ODM66QJ/iadAvxOwyrMZgs8lUNThT",
+	"66Vbjm2WZnjrkLPzeJ6C5ETD3Z4pL5G2ZKxtmiVIlXAddHwXTzHSEerd3QN3XWZ5hSmOEMp1EQGgb3jA",
+	"jAlBkYHXjLRlMItwEe96TxdZnIzdIWNXVNMzyyKmlqDa1BKVX9hJA6uU0nlY9HUxnpbvDZP5XMfc+9hb",
+	"CrG6sLNk1xwt6turfnJsuGpejEd1c6yvb6GVRcXr6ifGZ3Z3/8w43c9fH798+fJHwm777LPPPivNzJQm",
+	"JmLJ9S1JWW5nxKrEhN9116l1Fw0TPeV6eZvv9HIqfLJwkaXm3O47Xe7zezCYpN6pTW5ANMOShRYGaJRB",
+	"zF4WyntrqXKXS9JEZj1Zm86+jF8STWPWtZL8QQwkLok/QlIshX8hrpasShepclWrZmUs/lJZj+q4R8rl",
+	"TvZK8iCkzukqfeW1aPXD/G1foU6nREJ8xDuLzSY7xCudrvJGaJPMzUvKfl9WEZ/rqs6uYB2WHiGkj3in",
+	"p9cEfZ4Y6wo9TwSThR6hof3oPbIxWHExxGC5BJa2JqQv2xTaG8xVexTKYGnTPBcOR7y8wBgB8wkf9l8K",
+	"IxFn1y/hDMo+OeeyjedevWmnawHSoUf/2SUrnY+vIprsErvi0ujlK6NXf9Y/bwYfNfBT8GdA6EByzzFZ",
+	"SsQCLyyEkFl9z0y5sJgWYMcmUjFgYQnES4yRvUWrnVl9J7zCMl7JP3gab4drMuTPKdf4PssrC77Un99Z",
+	"xP7EeAkR9DMouWyjmE3arRNPCmC9flhJClzNuq1EhlQ6lDbPVx+OmjgFe3SqlZWwVw4j/v5zzNhLzmfW",
+	"SsdRKPKa3j3ThX4Qjas/BQexrvF23JWVVaDaxlWtfzw28fCeeqnVXS3+mEJ5It8rqDHMV0omN7XfC7Z9",
+	"Pjr03HyoIGcGqk5YARlUNmJsmS3yo+L0wVuFunwiqSQzvmPvoK9YYx9MqOeh7TBDg4taqVm5KQajwIhw",
+	"3MRKeFGUl7Jm2LbjEyEMiGMTtga0VQAUtjNu2BWrwuP48XUFe7FUCZGIf8S9XC3m+uchycyl3ZpdGR+7",
+	"NTE1wRo3RquzHcIKnkR/fGw9sybWQywbY91iof4Y5yyJhX6fe2hYSJRyvKkiDsf5m1hcYR0mEyAWXI5Y",
+	"HgFYC/ZHfIf4m5bHIb2j9wtd6Xe0iRmoUjriS+FCDhsjRVkRajktzQk+rcqTpQSgDXbC+8XtodR/Qdtp",
+	"yDd5F9KXsCu4BC9jAQ8e4OgxCJRroSXHF2b6S29LF+b7TtW5SG9pkxuqLB2nKYo4WW0MPY7tDy7McIkU",
+	"c5b2OX7zzgfdqRl2WBXBZBrHmROeRQZAVsUUP+RgLPSYOIJEDKxFPsRRijwPQnQQyxrLWmAs52l9IzBh",
+	"a/jeJT7dJpfewqk5CgpTwSW6RJrxgPM3C1y9EHVnL3gHzqHWuiQz2P4/dqe3xGc0FfE/ssacwt1FD36K",
+	"qBsNdUug7x9lFk8GEv7LlM8ubh5Dx7+OpYyq/LgQyNrZ1IGnJkDBrvuFGF1VW4ycEXZmFVsIRIwnw8Ri",
+	"2dPSaKduQ9LvCVbHM2TiOWnF8DfNPIcfWpUd5s+pmr6ZRu0J/D6O2jFcuKIe1xwd2T4e15vgW2HpvCfg",
+	"/itbt6pzyj4ZCHbBIVeKe6d4x/yoAV7o6WzSg8I8JiurKOcQyu+IIN9XqUCbRQ+je50BnDDL0jio+Dni",
+	"SLPzkBSxgWx9khSJZ57S69d3xPwTfc5qQhi3EdHLR+8pvv4hyvBCZiINWNJJNKpGOJxkXlMYuxvKfojo",
+	"QPnbUWcukLR3JP0P2owhaUJOgrZ9GHzbA3LKukrmPMM8Uam+6TwOX/1mtZ8dKjNeovH8oygXo8e8PQ/q",
+	"eyxGFvYxe6+Q43eqHWBL9VK8p3z3KIGzyTqa/uyqv3XLPxyu98Eb/kr3PW2dqwuAzws+K5EpD/Hrk8SM",
+	"P/Ld2P8ItQvzv7j532meMKtcPxA1dGiMKupKWVAEYLvHPLU/suIC0WjvuEfW3IVjISSYvw2/wu/pIato",
+	"R3kftmjqdKpYACCnXgdf87iWpHH1w8WgPo7yuyH591/6BF+/c1fDGUukD9bTkImgH7ijgSVS6ETMuxUy",
+	"JfI59Mu/8DegK13gZf98CxnS8ZpI/cF0ylj/D9Y+WCptlaPasbGwBZUc3zRqw0alkp2OP1ap4OTnU6SZ",
+	"hmOg78bmlzJ1Vso/HZGnh45q2OcZDdC8my7Fb/rEWUUhIg1C1erGNmuYXTjTaDHMrepzowCfD9F+1yBZ",
+	"Nda2TLuSm0Iv1loAUOnRwEXno8jtXmKdbZvFC4bkPMJKg/1uRvO1MxPXpMYyOEqRVVjDkaxbZrWCFXd4",
+	"THdHloeiIZVRglv4Mk+0Cbxbjq7cWZavTT9qlHS4f2cnHNGat43FybGZlclfTS0sLsRWF543MarYu4zw",
+	"h/U35y5xhqJJcdw4w4TJAWmlg6FlJ7UqjqbiRzVbkJE4IJ3U4HvaAiLhSUaTLzH8Hgu2IBYb3vhtsDdM",
+	"25IYfCbKCzLmXMhun0UcJdCZ99807ErVnDHqdXAj5AmC+JWnkAib+CBtVPt41VnVEsyrMG7Gl3PmQ/9q",
+	"EYS6WVWcN4qHFOxv2JbGiSIpYVIrhh/EeL73J2f9vEqNfsByoTBAI9QtqTtZ8DU6h9HhMD47MTl759bk",
+	"/EKWRvZNVCUq8bpDidTGnYo5e9823SL0xi7ctOrzYlJ2Fr3FrzxNoQ88aIXNFhm9q02Y92bruNRocPNw",
+	"2KassjqsqbWFkFBFseByz0VB8oKkzNl0e65k9zCx4JQ37f9ggiv0pzrhVXls9G+TNSDGMTrRWWvFGoMV",
+	"nGkagaVgFnBU6ZSbACynNYudn/+0U5fjad6G4qia3Ac+oVipjtSzsM2y95/jF28uGJ3C9yqg00p3fGyn",
+	"Oj4SueEj0AgvQzgMviI4J4W5ZJuJGomsCoPiTI85zQvqGROKi89ljKVQSjr25Y+hNr/r/OdZMqBWOndU",
+	"FheeTuXg8YkLKuyQWRnsZkBQoX/ExFGvZFVQnZhQXHwxHTaHmrKW1xU5xcXZBREVJKJuBFlPpNOh4yH3",
+	"eHYXwoKbIL7wCyxN67HY7CfkNg19hN17TRVDEvnEmaQ6cUEDyYDrfrxKtIDLqIPPJ7/6TJDDwvRYJ4qA",
+	"S94JUWTOlKgWwklYd2rMRNUoxMEXpscuUDYTZRXQOTUubsqaf35O5M34pX3FrZq0gEImfcLv18GyDx/f",
+	"u1b+Ho1j/B0fYqzeRlo1PqQHXSrHBRCLDWmGJzjsCdn9f/7C+smTUjizO+YmZH2LxKCFIUL/i18EFda4",
+	"hd+Igcqim3pTzEN+E41MZm1uv8LfW4yMRnE4bDjNjse8l2z6Kgoe0zd87kJivkOMDlkohdeAB0+xaDms",
+	"AG/T4yFCfwgbQvIeraIWWkyEDtVVcKV+zQaRvOLrw6CGvmSjIyF+esFTUYOefeA8pi2aygfPwu75OJtV",
+	"jMqlh9mjoqWD7IdQyrKD4uNIQvVM+4ehDYfw/31sgM5FPjbWauYwV5uW7OGKs+YNsyvgz4/5zUNrTu28",
+	"JxcnTLJwXHkeQ4sALGYbw79pc409rBAfS89ADkeG08MLwZqbLJJH7EhoUd3FMRK8YF2ndzQ4stcgXyTP",
+	"xi/9SamLbiM5zqQLj3Y6FBELEOS74KJLdb6Krr0HzfdKDT2lIZQ7uCjhxk6IvQPCOcoz+pIeCTHcio9a",
+	"75NG4Zn+BNvojPFgtm7ypnxethdugVlS6rtOEeDj8F6pGQ9WnLpp8w5znjZ6tYBR34U3L/tFqW7HjWo1",
+	"DJy2UbrvB3uxhJBS2KwmGhrZlMfAlHV8DI6F4z6MpHeum0BdJlW+C39jNhy73HJx1pP50kLc6H+JMyJ8",
+	"MFNTpKVEbd8upPd2HFTxLM1Yl6Fwgl7CpZNiaW+Z6/gYbYsnvLjtm24zfTzTv+X41jrH0Dvm6qbjbHVk",
+	"Vap7ziVu0FX83VWMj6f/A1qrItC+CZt/b/p+fcAbJLfnp69FI8aabKSx1CCfG2tYndJkQ+/2sUcr6yf3",
+	"RpYkhekPlnn+rMa0gZnIoY1Vx6mahn0KTiKeWYhxfEebIXBfI3hTCUzNa/Bdkx7DwRAxmQHS4A7gquDf",
+	"pcGTJYI5HnGdl89PFC31LrhRrG75kD4PHgf79EiJxfR1kuUMLFSNta3hGUw5qTmeX2LVs9ioD8ue0cbo",
+	"Pt3QCx3NuUyHeZp71obWLdfzVwRUVjadBlgfV3St7lStNYDJ2AQklP5yavLO5LzWh7AHczGfMSGfk7tb",
+	"Js6oHPGClkKH91te699OjRhTdvAUzXNRveUSnk9s5XnP+O8TqUmpspSjE4E1sFCVz1G2qpa/3bGyA9LQ",
+	"btvyLachO9OueCtG1Kp6pDRSXixLrapd08C7tHsGewrrQO36idvKl2O3ZWcHd6FOhItTNnt/hVnr0Vp0",
+	"bd1xa7ioiuGbJd9CsZdSPMSOHqbGjqYulTb6sODTu2ybnqB5cbf8aj2Ew/mnEcbQrNOW4kiZ3Frsxy5a",
+	"gLdAZUhlFV4wuFTTYg6ohNmO3pcB+JZpzvRID0MefEY4atmDoKMdEDbolaAKB21gniWMn8N47+lwgq7E",
+	"81jHk654XlShn5cI1QfOd5EN1XU2lIRb6YYHFwSozITqQI+nI5aOExGVdNKdT5+JpzPx6CdFSrHc+4Rs",
+	"SXv2+yV3TyWmcLK+4rw/ZL9/x91nFk13IIN8Zz4jjA3TZ574vDwoVjCPV3Xqcf8HFrLA7qlfMsWfRdhz",
+	"Kr+FP/yEF3kPcAf6klYzl7RBUsq6l3dP+fmdxYwO+ZI2GBFTWP3zrwM18781/vnuSOmj5bvl0kfL/zD4",
+	"d1rhmYlnNwQ1Z5KkVZEGSIaDHvW8WZKKCZGJKZLqOZGphi5N1mIieJQa2ThE+GwDnCMJxp8Y+JhwZ0kJ",
+	"D5gcA537wZ8F/phj2ahs02Nd4IU0QTLjmNPjKz/oiZUZs/4YPaamKfVzVNJynl0al1Lyqalq3E49SGJh",
+	"ExMzzk6QdT+IIjGc8u/ZMIUs5n2h/2VnAc/N/33wVCf0BYiT3DlMhcb49EFKLqxtmhVebJInJ8PrfkIa",
+	"oyetPY8k7jjuVrj+lDdV/FCIEv4M4Q9ODU0+lmggI6inY1Yhi5mjgfwKMx/g1/bgBZlIZPJnns0IyI69",
+	"b9JAPkNd0TP9yZphVc9yhqQ88Cw5NfKATettwmQowJihJZt+D5sPvoZH8KSX4DGqFK1gT2fAQN9zeJOE",
+	"XddQ44j0Th7/bNJXwVN6IGsrPGSKGWYmgABenY7wRXCSA8gen2f1Vl7qiSiOa7G0WFXK5wLjKAzmp3FR",
+	"s0PTVp1VOR2zb05mgROpRK6MOHPoBeGNPcKzOUt3MFvlOwhEC+i8k52lkgMEFVzEu3LYbAQmEbM6BP6E",
+	"hsohPYjmi73kEyB/5BHipjqIpWK4+Xx2yhvjRWw5QWPEDHHhKfiDVDLHCbwvfEF6rCoJo1/oH73mXIba",
+	"wovVQMttHpJVfphjQYk3dYKOEjYFU9OVzQaz1O0LHiF7rGPZgLwM/ktM2n1BpObAUc/8HjWztDPbM7tJ",
+	"vYVnnjLntify71ey7JsorTBZdNddymx/GM75axC9Zcv2i71epM2efdqsCCHHkD0vh7aPnCRmqbxLyw6N",
+	"/7hF1QIz64/wpZQJGb/3CS6lyU05VIVLCrUs07QDU5pVHpKElZtjkcVhdi7cNDeftW+WRG6C61nyOFuG",
+	"6Mq5bFb9yr7l9l7wuFgybmrogRD6iV/UCec5QxUOBA84PJXBJbt3Mzjgd7w+WcyY5pmLkc/tGUascBY7",
+	"9xtBdDLtpeP7UXhCycDtxXGdlD8aLZdLI///aLmMPqyTEn0b7A0OZfEjyefcsxEI6Xgrv3ZsUxvVJhtA",
+	"nMMzjrfm3E/bMPcdd2ulYmwDWEf0S/pl/Yp+dZl/D1bPqDYCOxCXYiYefAm5jT2bk9ICT+9MiW1Cij2F",
+	"ccN/yo8apqNO0eaTiHPz5ujMjMq1JQOn4E0/GaX0JxJSuGgKe1bxAjLAfV+Y/d1KhdqQjUU66WAXuud9",
+	"VlHlDW9YftVYzWa5Y3NTJSEfRO4k67VNT0bJDcufNlbZBl7yzAEoi3kpapd2sQTkWaxGBuQK48sxbfMQ",
+	"WlscRCUjtMk9/Pha3lSVdW/Y5Uwfw7zPQT1nHF0kL4jSqUvlMl5DWAydWBu245oVMGlbAoyxPRzwVhTP",
+	"wl4XreCxSgu9gWCL6tLyM3J+iDaf2KLIW9gUsR/umPpVib2htOhsmfk9G/SHqrZXjxDtmhgwOchS3Ze0",
+	"GdPdMAknQnLTcbaWtI5rmrwH3KpjUkX/h5Gwo8I18yWzpZyFkzEvZyNMyeBZPsVrdjjGzJteo8rXrWi5",
+	"w1EaiK0dnmVbmPCx2WRt+pYnxZya8fbliDr37f8O/fdAyMzgTqB6xKHPaT1/YIyFvggeiSGG0PJMsAWR",
+	"Li7xKQglDsAPwR65MbU4PfbJyp3JT27Ozn66sjA5Pj+5ONir2DDXGi6mlN5dzqykqyHJcsxkfJStVWL/",
+	"U6Akudw4RilQMx6UVp3Kdml12weKuDLy0ZXL5Ss7idc+1Mbq1qfm9lgDCkruLgN3+cQ0XNMNv1kOX/NQ",
+	"cAZWIbSjh18w8SN9ISUNxb6XuiBI346hpEpzNhgqQy4NlUVMaKoyh16H39AWfQ7OCp7//yUwcXBTwsHi",
+	"oFQyEI7HGdQJlykkjhwho4Mb5OXEoCl9f9M0qv6mtrO88/8GAAHRjbEbJAEA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,152 @@
+package httpapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) CreatePullRequest(ctx context.Context, request openapi.CreatePullRequestRequestObject) (openapi.CreatePullRequestResponseObject, error) {
+	body := request.Body
+	authorID, err := parseID("author_id", body.AuthorId, "u")
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.prs.CreatePR(ctx, body.PullRequestName, authorID, body.TeamId, deref(body.ChangedFiles))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to create PR", zap.Error(err), zap.Int("author_id", authorID))
+		return nil, serviceError(err, "INTERNAL", "pull request not found")
+	}
+
+	logger.FromContext(ctx).Info("Created new Pull Request", zap.Int("pr_id", pr.ID), zap.Int("author_id", authorID))
+	return openapi.CreatePullRequest201JSONResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, request openapi.MergePullRequestRequestObject) (openapi.MergePullRequestResponseObject, error) {
+	id, err := parseID("pull_request_id", request.Body.PullRequestId, "pr-")
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.prs.MergePR(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to merge PR", zap.Error(err), zap.Int("pr_id", id))
+		return nil, serviceError(err, "INTERNAL", "pull request "+prID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("Merged Pull Request", zap.Int("pr_id", pr.ID))
+	return openapi.MergePullRequest200JSONResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *Server) GetPullRequest(ctx context.Context, request openapi.GetPullRequestRequestObject) (openapi.GetPullRequestResponseObject, error) {
+	id, err := parseID("pull_request_id", request.Params.PullRequestId, "pr-")
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.prs.GetPRDetails(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR", zap.Error(err), zap.Int("pr_id", id))
+		return nil, serviceError(err, "INTERNAL", "pull request "+prID(id)+" not found")
+	}
+
+	var resp openapi.GetPullRequest200JSONResponse
+	resp.Body.Pr = toPullRequestDetails(details)
+	etag, err := etagOf(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Клиент прислал тот же ETag - тело не отдаём
+	if etagMatches(deref(request.Params.IfNoneMatch), etag) {
+		return openapi.GetPullRequest304Response{Headers: openapi.GetPullRequest304ResponseHeaders{ETag: etag, CacheControl: "no-cache"}}, nil
+	}
+	resp.Headers = openapi.GetPullRequest200ResponseHeaders{ETag: etag, CacheControl: "no-cache"}
+	return resp, nil
+}
+
+func (s *Server) ListPullRequests(ctx context.Context, request openapi.ListPullRequestsRequestObject) (openapi.ListPullRequestsResponseObject, error) {
+	params := request.Params
+	filter := listFilter(params.Status, string(deref(params.Sort)), params.Limit, params.Cursor)
+	filter.TeamName = deref(params.TeamName)
+	filter.Query = deref(params.Q)
+
+	var err error
+	if params.AuthorId != nil {
+		if filter.AuthorID, err = parseID("author_id", *params.AuthorId, "u"); err != nil {
+			return nil, err
+		}
+	}
+	if params.ReviewerId != nil {
+		if filter.ReviewerID, err = parseID("reviewer_id", *params.ReviewerId, "u"); err != nil {
+			return nil, err
+		}
+	}
+	if filter.CreatedFrom, err = parseTime("created_from", params.CreatedFrom); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = parseTime("created_to", params.CreatedTo); err != nil {
+		return nil, err
+	}
+	if filter.MergedFrom, err = parseTime("merged_from", params.MergedFrom); err != nil {
+		return nil, err
+	}
+	if filter.MergedTo, err = parseTime("merged_to", params.MergedTo); err != nil {
+		return nil, err
+	}
+
+	page, err := s.prs.ListPRs(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list PRs", zap.Error(err))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	resp := openapi.ListPullRequests200JSONResponse{PullRequests: toPullRequests(page.PullRequests)}
+	if page.NextCursor != "" {
+		resp.NextCursor = &page.NextCursor
+	}
+	logger.FromContext(ctx).Info("Listed PRs", zap.Int("count", len(page.PullRequests)), zap.Bool("has_more", page.NextCursor != ""))
+	return resp, nil
+}
+
+func (s *Server) ReassignReviewer(ctx context.Context, request openapi.ReassignReviewerRequestObject) (openapi.ReassignReviewerResponseObject, error) {
+	id, err := parseID("pull_request_id", request.Body.PullRequestId, "pr-")
+	if err != nil {
+		return nil, err
+	}
+	oldUserID, err := parseID("old_user_id", request.Body.OldUserId, "u")
+	if err != nil {
+		return nil, err
+	}
+
+	// Пользователь по JWT может переназначить только собственное ревью
+	if p := middleware.PrincipalFrom(ctx); p != nil && p.Role == models.RoleReviewer && p.UserID != oldUserID {
+		logger.FromContext(ctx).Warn("Reviewer tried to reassign someone else's review",
+			zap.Int("user_id", p.UserID), zap.Int("old_user_id", oldUserID), zap.Int("pr_id", id))
+		return nil, newError("FORBIDDEN", "reviewers can reassign only their own reviews")
+	}
+
+	pr, newReviewerID, err := s.prs.ReassignReviewer(ctx, id, oldUserID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to reassign reviewer", zap.Error(err), zap.Int("pr_id", id), zap.Int("old_user_id", oldUserID))
+		return nil, serviceError(err, "NO_CANDIDATE", "pull request "+prID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("Reassigned PR reviewer",
+		zap.Int("pr_id", pr.ID), zap.Int("old_user_id", oldUserID), zap.Int("new_user_id", newReviewerID))
+	return openapi.ReassignReviewer200JSONResponse{Pr: toPullRequest(pr), ReplacedBy: userID(newReviewerID)}, nil
+}
+
+// ListUnderstaffedPullRequests - открытые PR, которым не хватило ревьюверов при назначении
+func (s *Server) ListUnderstaffedPullRequests(ctx context.Context, request openapi.ListUnderstaffedPullRequestsRequestObject) (openapi.ListUnderstaffedPullRequestsResponseObject, error) {
+	name := deref(request.Params.TeamName)
+	prs, err := s.prs.ListUnderstaffed(ctx, name)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list understaffed PRs", zap.Error(err), zap.String("team_name", name))
+		return nil, serviceError(err, "INTERNAL", "not found")
+	}
+
+	logger.FromContext(ctx).Info("Listed understaffed PRs", zap.String("team_name", name), zap.Int("count", len(prs)))
+	return openapi.ListUnderstaffedPullRequests200JSONResponse{PullRequests: toPullRequests(prs)}, nil
+}
@@ -0,0 +1,393 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// SCIM 2.0 для IdP (Okta, Azure AD, Keycloak, ...). Ключ IdP - с ролью admin, передаётся
+// как Authorization: Bearer. Ошибки - scimError, а не ErrorResponse
+
+// Размер страницы SCIM-списков (count)
+const (
+	defaultScimPageSize = 100
+	maxScimPageSize     = 500
+)
+
+func (s *Server) GetScimServiceProviderConfig(ctx context.Context, request openapi.GetScimServiceProviderConfigRequestObject) (openapi.GetScimServiceProviderConfigResponseObject, error) {
+	return openapi.GetScimServiceProviderConfig200ApplicationScimPlusJSONResponse{
+		Schemas:        []string{models.ScimServiceConfigSchema},
+		Patch:          openapi.ScimSupported{Supported: true},
+		Bulk:           openapi.ScimBulkConfig{Supported: false},
+		Filter:         openapi.ScimFilterConfig{Supported: true, MaxResults: maxScimPageSize},
+		ChangePassword: openapi.ScimSupported{Supported: false},
+		Sort:           openapi.ScimSupported{Supported: false},
+		Etag:           openapi.ScimSupported{Supported: false},
+		AuthenticationSchemes: []openapi.ScimAuthenticationScheme{
+			{Type: "oauthbearertoken", Name: "API key", Description: "Authorization: Bearer <API key>"},
+		},
+	}, nil
+}
+
+func (s *Server) ListScimUsers(ctx context.Context, request openapi.ListScimUsersRequestObject) (openapi.ListScimUsersResponseObject, error) {
+	params := request.Params
+	filter, offset, limit, err := scimListParams(params.Filter, params.StartIndex, params.Count)
+	if err != nil {
+		return nil, err
+	}
+	users, total, err := s.scim.ListUsers(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+
+	resp := openapi.ListScimUsers200ApplicationScimPlusJSONResponse{
+		Schemas:      []string{models.ScimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   offset + 1,
+		ItemsPerPage: len(users),
+		Resources:    make([]openapi.ScimUser, 0, len(users)),
+	}
+	for i := range users {
+		resp.Resources = append(resp.Resources, toScimUser(&users[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateScimUser(ctx context.Context, request openapi.CreateScimUserRequestObject) (openapi.CreateScimUserResponseObject, error) {
+	user, err := fromScimUser(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.scim.CreateUser(ctx, user); err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	created, err := s.scim.GetUser(ctx, user.ID)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+
+	out := toScimUser(created)
+	return openapi.CreateScimUser201ApplicationScimPlusJSONResponse{
+		Body:    out,
+		Headers: openapi.CreateScimUser201ResponseHeaders{Location: out.Meta.Location},
+	}, nil
+}
+
+func (s *Server) GetScimUser(ctx context.Context, request openapi.GetScimUserRequestObject) (openapi.GetScimUserResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.scim.GetUser(ctx, id)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.GetScimUser200ApplicationScimPlusJSONResponse(toScimUser(user)), nil
+}
+
+func (s *Server) ReplaceScimUser(ctx context.Context, request openapi.ReplaceScimUserRequestObject) (openapi.ReplaceScimUserResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	user, err := fromScimUser(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	user.ID = id
+	updated, err := s.scim.ReplaceUser(ctx, user)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.ReplaceScimUser200ApplicationScimPlusJSONResponse(toScimUser(updated)), nil
+}
+
+func (s *Server) PatchScimUser(ctx context.Context, request openapi.PatchScimUserRequestObject) (openapi.PatchScimUserResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := fromScimPatch(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.scim.PatchUser(ctx, id, ops)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.PatchScimUser200ApplicationScimPlusJSONResponse(toScimUser(updated)), nil
+}
+
+func (s *Server) DeleteScimUser(ctx context.Context, request openapi.DeleteScimUserRequestObject) (openapi.DeleteScimUserResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.scim.DeleteUser(ctx, id); err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.DeleteScimUser204Response{}, nil
+}
+
+func (s *Server) ListScimGroups(ctx context.Context, request openapi.ListScimGroupsRequestObject) (openapi.ListScimGroupsResponseObject, error) {
+	params := request.Params
+	filter, offset, limit, err := scimListParams(params.Filter, params.StartIndex, params.Count)
+	if err != nil {
+		return nil, err
+	}
+	groups, total, err := s.scim.ListGroups(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+
+	resp := openapi.ListScimGroups200ApplicationScimPlusJSONResponse{
+		Schemas:      []string{models.ScimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   offset + 1,
+		ItemsPerPage: len(groups),
+		Resources:    make([]openapi.ScimGroup, 0, len(groups)),
+	}
+	for i := range groups {
+		resp.Resources = append(resp.Resources, toScimGroup(&groups[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateScimGroup(ctx context.Context, request openapi.CreateScimGroupRequestObject) (openapi.CreateScimGroupResponseObject, error) {
+	group, err := fromScimGroup(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.scim.CreateGroup(ctx, group)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+
+	out := toScimGroup(created)
+	return openapi.CreateScimGroup201ApplicationScimPlusJSONResponse{
+		Body:    out,
+		Headers: openapi.CreateScimGroup201ResponseHeaders{Location: out.Meta.Location},
+	}, nil
+}
+
+func (s *Server) GetScimGroup(ctx context.Context, request openapi.GetScimGroupRequestObject) (openapi.GetScimGroupResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.scim.GetGroup(ctx, id)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.GetScimGroup200ApplicationScimPlusJSONResponse(toScimGroup(group)), nil
+}
+
+func (s *Server) ReplaceScimGroup(ctx context.Context, request openapi.ReplaceScimGroupRequestObject) (openapi.ReplaceScimGroupResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	group, err := fromScimGroup(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	group.ID = id
+	updated, err := s.scim.ReplaceGroup(ctx, group)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.ReplaceScimGroup200ApplicationScimPlusJSONResponse(toScimGroup(updated)), nil
+}
+
+func (s *Server) PatchScimGroup(ctx context.Context, request openapi.PatchScimGroupRequestObject) (openapi.PatchScimGroupResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := fromScimPatch(scimBody(request.JSONBody, request.ApplicationScimPlusJSONBody))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.scim.PatchGroup(ctx, id, ops)
+	if err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.PatchScimGroup200ApplicationScimPlusJSONResponse(toScimGroup(updated)), nil
+}
+
+func (s *Server) DeleteScimGroup(ctx context.Context, request openapi.DeleteScimGroupRequestObject) (openapi.DeleteScimGroupResponseObject, error) {
+	id, err := scimID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.scim.DeleteGroup(ctx, id); err != nil {
+		return nil, scimServiceError(ctx, err)
+	}
+	return openapi.DeleteScimGroup204Response{}, nil
+}
+
+var scimFilterExpr = regexp.MustCompile(`(?i)^\s*(\w+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// scimListParams - filter, startIndex (с 1) и count; возвращает смещение и размер страницы
+func scimListParams(filterExpr *string, startIndex, count *int) (*models.ScimFilter, int, int, error) {
+	var filter *models.ScimFilter
+	if v := deref(filterExpr); v != "" {
+		m := scimFilterExpr.FindStringSubmatch(v)
+		if m == nil {
+			return nil, 0, 0, &scimError{status: http.StatusBadRequest, scimType: openapi.InvalidFilter,
+				detail: `only filters of the form <attr> eq "<value>" are supported`}
+		}
+		var value string
+		if err := json.Unmarshal([]byte(`"`+m[2]+`"`), &value); err != nil {
+			return nil, 0, 0, &scimError{status: http.StatusBadRequest, scimType: openapi.InvalidFilter,
+				detail: fmt.Sprintf("invalid filter value: %v", err)}
+		}
+		filter = &models.ScimFilter{Attr: m[1], Value: value}
+	}
+
+	start, limit := 1, defaultScimPageSize
+	if startIndex != nil && *startIndex > 1 {
+		start = *startIndex
+	}
+	if count != nil {
+		limit = min(max(*count, 0), maxScimPageSize)
+	}
+	return filter, start - 1, limit, nil
+}
+
+// scimID - чужих id у нас нет: для IdP неверный id - просто отсутствующий ресурс
+func scimID(value string) (int, error) {
+	id, err := models.ParseScimID(value)
+	if err != nil {
+		return 0, &scimError{status: http.StatusNotFound, detail: err.Error()}
+	}
+	return id, nil
+}
+
+// scimBody - тело запроса: application/scim+json или application/json
+func scimBody[T any](jsonBody, scimJSONBody *T) *T {
+	if scimJSONBody != nil {
+		return scimJSONBody
+	}
+	if jsonBody != nil {
+		return jsonBody
+	}
+	return new(T)
+}
+
+func scimServiceError(ctx context.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case msg == "not found":
+		return &scimError{status: http.StatusNotFound, detail: "resource not found"}
+	case strings.HasPrefix(msg, "CONFLICT"):
+		return &scimError{status: http.StatusConflict, scimType: openapi.Uniqueness, detail: strings.TrimPrefix(msg, "CONFLICT: ")}
+	case strings.HasPrefix(msg, "BAD_REQUEST"):
+		return &scimError{status: http.StatusBadRequest, scimType: openapi.InvalidValue, detail: strings.TrimPrefix(msg, "BAD_REQUEST: ")}
+	}
+	logger.FromContext(ctx).Error("SCIM request failed", zap.Error(err))
+	return &scimError{status: http.StatusInternalServerError, detail: "internal error"}
+}
+
+func invalidSCIMValue(field string, err error) error {
+	return &scimError{status: http.StatusBadRequest, scimType: openapi.InvalidValue, detail: fmt.Sprintf("%s: %v", field, err)}
+}
+
+func toScimUser(u *models.ScimUser) openapi.ScimUser {
+	id := strconv.Itoa(u.ID)
+	out := openapi.ScimUser{
+		Schemas:     []string{models.ScimUserSchema},
+		Id:          id,
+		UserName:    u.UserName,
+		DisplayName: u.UserName,
+		Active:      u.Active,
+		Groups:      toScimRefs(u.Groups),
+		Meta:        openapi.ScimMeta{ResourceType: openapi.ScimMetaResourceTypeUser, Location: "/scim/v2/Users/" + id},
+	}
+	if u.ExternalID != "" {
+		out.ExternalId = &u.ExternalID
+	}
+	return out
+}
+
+func toScimGroup(g *models.ScimGroup) openapi.ScimGroup {
+	id := strconv.Itoa(g.ID)
+	out := openapi.ScimGroup{
+		Schemas:     []string{models.ScimGroupSchema},
+		Id:          id,
+		DisplayName: g.DisplayName,
+		Members:     toScimRefs(g.Members),
+		Meta:        openapi.ScimMeta{ResourceType: openapi.ScimMetaResourceTypeGroup, Location: "/scim/v2/Groups/" + id},
+	}
+	if g.ExternalID != "" {
+		out.ExternalId = &g.ExternalID
+	}
+	return out
+}
+
+func toScimRefs(refs []models.ScimRef) []openapi.ScimRef {
+	out := make([]openapi.ScimRef, 0, len(refs))
+	for _, r := range refs {
+		ref := openapi.ScimRef{Value: strconv.Itoa(r.ID)}
+		if r.Display != "" {
+			ref.Display = &r.Display
+		}
+		out = append(out, ref)
+	}
+	return out
+}
+
+// fromScimUser - тело POST/PUT /Users; active по умолчанию true, некоторые IdP присылают его строкой
+func fromScimUser(req *openapi.ScimUserRequest) (*models.ScimUser, error) {
+	user := &models.ScimUser{ExternalID: deref(req.ExternalId), UserName: deref(req.UserName), Active: true}
+	if req.Active != nil {
+		raw, err := json.Marshal(req.Active)
+		if err != nil {
+			return nil, invalidSCIMValue("active", err)
+		}
+		if user.Active, err = models.ParseScimBool(raw); err != nil {
+			return nil, invalidSCIMValue("active", err)
+		}
+	}
+	return user, nil
+}
+
+// fromScimGroup - тело POST/PUT /Groups
+func fromScimGroup(req *openapi.ScimGroupRequest) (*models.ScimGroup, error) {
+	group := &models.ScimGroup{ExternalID: deref(req.ExternalId), DisplayName: deref(req.DisplayName)}
+	for _, m := range deref(req.Members) {
+		id, err := models.ParseScimID(m.Value)
+		if err != nil {
+			return nil, invalidSCIMValue("members", err)
+		}
+		group.Members = append(group.Members, models.ScimRef{ID: id})
+	}
+	return group, nil
+}
+
+// fromScimPatch - value операции сервис разбирает сам: его тип зависит от path
+func fromScimPatch(req *openapi.ScimPatchRequest) ([]models.ScimPatchOp, error) {
+	ops := make([]models.ScimPatchOp, 0, len(req.Operations))
+	for _, op := range req.Operations {
+		var value json.RawMessage
+		if op.Value != nil {
+			raw, err := json.Marshal(op.Value)
+			if err != nil {
+				return nil, invalidSCIMValue("value", err)
+			}
+			value = raw
+		}
+		ops = append(ops, models.ScimPatchOp{Op: op.Op, Path: deref(op.Path), Value: value})
+	}
+	return ops, nil
+}
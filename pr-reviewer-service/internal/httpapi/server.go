@@ -0,0 +1,169 @@
+package httpapi
+
+import (
+	"context"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/graphqlapi"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/services"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/graphql-go/graphql"
+)
+
+// Что операции берут из сервисов (в тестах их заменяют фейки)
+type (
+	teamService interface {
+		AddTeam(ctx context.Context, team *models.Team) error
+		GetTeam(ctx context.Context, name string) (*models.Team, error)
+		SetDefaultMaxOpenReviews(ctx context.Context, teamName string, limit *int) error
+	}
+	userService interface {
+		SetIsActive(ctx context.Context, userID int, isActive bool) (*models.User, error)
+		GetReview(ctx context.Context, userID int, filter models.PRListFilter) (*models.PRListPage, error)
+		AddUnavailability(ctx context.Context, u *models.Unavailability) error
+		ListUnavailability(ctx context.Context, userID int) ([]models.Unavailability, error)
+		DeleteUnavailability(ctx context.Context, id int) error
+		SetSchedule(ctx context.Context, sch *models.WorkSchedule) error
+		GetSchedule(ctx context.Context, userID int) (*models.WorkSchedule, error)
+		SetMaxOpenReviews(ctx context.Context, userID int, limit *int) error
+		SetEmail(ctx context.Context, userID int, email string) error
+	}
+	prService interface {
+		CreatePR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string) (*models.PullRequest, error)
+		MergePR(ctx context.Context, prID int) (*models.PullRequest, error)
+		ReassignReviewer(ctx context.Context, prID int, oldReviewerID int) (*models.PullRequest, int, error)
+		ListUnderstaffed(ctx context.Context, teamName string) ([]models.PullRequest, error)
+		ListPRs(ctx context.Context, filter models.PRListFilter) (*models.PRListPage, error)
+		GetPRDetails(ctx context.Context, prID int) (*models.PRDetails, error)
+	}
+	healthService interface {
+		Ready(ctx context.Context) (bool, map[string]services.Check)
+	}
+	slaService interface {
+		SetTeamSLA(ctx context.Context, sla *models.TeamSLA) error
+		GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error)
+	}
+	codeOwnersService interface {
+		AddRule(ctx context.Context, rule *models.OwnershipRule) error
+		ListRules(ctx context.Context, teamName string) ([]models.OwnershipRule, error)
+		DeleteRule(ctx context.Context, id int) error
+		AddHandleMapping(ctx context.Context, m *models.HandleMapping) error
+		ListHandleMappings(ctx context.Context) ([]models.HandleMapping, error)
+		DeleteHandleMapping(ctx context.Context, handle string) error
+		ImportCodeowners(ctx context.Context, teamName, content string) (*models.CodeownersImportReport, error)
+	}
+	apiKeyService interface {
+		CreateKey(ctx context.Context, name, role string) (string, *models.APIKey, error)
+		ListKeys(ctx context.Context) ([]models.APIKey, error)
+		RevokeKey(ctx context.Context, id int) error
+	}
+	bulkService interface {
+		Export(ctx context.Context) (*models.BulkData, error)
+		Import(ctx context.Context, data *models.BulkData, dryRun bool) (*models.ImportResult, error)
+	}
+	scimService interface {
+		GetUser(ctx context.Context, id int) (*models.ScimUser, error)
+		ListUsers(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimUser, int, error)
+		CreateUser(ctx context.Context, u *models.ScimUser) error
+		ReplaceUser(ctx context.Context, u *models.ScimUser) (*models.ScimUser, error)
+		PatchUser(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimUser, error)
+		DeleteUser(ctx context.Context, id int) error
+		GetGroup(ctx context.Context, id int) (*models.ScimGroup, error)
+		ListGroups(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimGroup, int, error)
+		CreateGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error)
+		ReplaceGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error)
+		PatchGroup(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimGroup, error)
+		DeleteGroup(ctx context.Context, id int) error
+	}
+	notificationService interface {
+		SetTeamWebhook(ctx context.Context, teamName, webhookURL string) error
+		SetUserNotifications(ctx context.Context, userID int, enabled bool) error
+	}
+	graphQLExecutor interface {
+		Execute(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Result
+	}
+	mergeRequestHandler interface {
+		HandleMergeRequest(ctx context.Context, ev *gitlab.MergeRequestEvent) (string, int, error)
+	}
+)
+
+// Services - сервисы, которые вызывают операции
+type Services struct {
+	Teams         *services.TeamService
+	Users         *services.UserService
+	PRs           *services.PRService
+	Health        *services.HealthService
+	SLA           *services.SLAService
+	CodeOwners    *services.CodeOwnersService
+	APIKeys       *services.APIKeyService
+	Bulk          *services.BulkService
+	SCIM          *services.ScimService
+	Notifications *services.NotificationService
+	GraphQL       *graphqlapi.Server
+	GitLab        *services.GitLabService
+	// GitLabSecret - секрет вебхука (X-Gitlab-Token); пустой - интеграция выключена
+	GitLabSecret string
+}
+
+// Server - операции из openapi.yml. Интерфейс, модели запросов и ответов сгенерированы
+// в internal/httpapi/openapi; роли и формат запросов и ответов проверяет validator
+type Server struct {
+	teams         teamService
+	users         userService
+	prs           prService
+	health        healthService
+	sla           slaService
+	codeOwners    codeOwnersService
+	apiKeys       apiKeyService
+	bulk          bulkService
+	scim          scimService
+	notifications notificationService
+	graphql       graphQLExecutor
+	gitlab        mergeRequestHandler
+	gitlabSecret  string
+	validator     *validator
+}
+
+// Если openapi.yml и операции разошлись, сервис не соберётся
+var _ openapi.StrictServerInterface = (*Server)(nil)
+
+// NewServer - responseValidation: off, log или strict (см. ResponseValidation*)
+func NewServer(svc Services, responseValidation string) (*Server, error) {
+	v, err := newValidator(responseValidation)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		teams:         svc.Teams,
+		users:         svc.Users,
+		prs:           svc.PRs,
+		health:        svc.Health,
+		sla:           svc.SLA,
+		codeOwners:    svc.CodeOwners,
+		apiKeys:       svc.APIKeys,
+		bulk:          svc.Bulk,
+		scim:          svc.SCIM,
+		notifications: svc.Notifications,
+		graphql:       svc.GraphQL,
+		gitlab:        svc.GitLab,
+		gitlabSecret:  svc.GitLabSecret,
+		validator:     v,
+	}, nil
+}
+
+// Register добавляет маршруты всех операций; остальные маршруты r не затрагиваются
+func (s *Server) Register(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(s.validator.middleware)
+		handler := openapi.NewStrictHandlerWithOptions(s, nil, openapi.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  requestErrorHandler,
+			ResponseErrorHandlerFunc: responseErrorHandler,
+		})
+		openapi.HandlerWithOptions(handler, openapi.ChiServerOptions{
+			BaseRouter:       r,
+			ErrorHandlerFunc: requestErrorHandler,
+		})
+	})
+}
@@ -0,0 +1,662 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/services"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/graphql-go/graphql"
+)
+
+// Ключи тестовых клиентов; ревьювер - пользователь u1
+const (
+	adminKey    = "admin-key"
+	botKey      = "bot-key"
+	readerKey   = "reader-key"
+	reviewerKey = "reviewer-key"
+
+	testWebhookSecret = "webhook-secret"
+)
+
+type fakeAuth struct{}
+
+func (fakeAuth) Authenticate(ctx context.Context, raw string) (*models.Principal, error) {
+	switch raw {
+	case adminKey:
+		return &models.Principal{Name: "admin", Role: models.RoleAdmin}, nil
+	case botKey:
+		return &models.Principal{Name: "bot", Role: models.RoleBot, KeyID: 2}, nil
+	case readerKey:
+		return &models.Principal{Name: "reader", Role: models.RoleReader, KeyID: 3}, nil
+	case reviewerKey:
+		return &models.Principal{Name: "alice", Role: models.RoleReviewer, UserID: 1}, nil
+	}
+	return nil, errors.New("not found")
+}
+
+// fakeServices - все сервисы сразу: отдают правдоподобные данные или err, если он задан
+type fakeServices struct {
+	err error
+}
+
+var testTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func (f *fakeServices) pr(id int) *models.PullRequest {
+	return &models.PullRequest{ID: id, Title: "Add search", AuthorID: 1, Status: "OPEN", AssignedReviewers: []int{2, 3}, CreatedAt: testTime}
+}
+
+func (f *fakeServices) AddTeam(ctx context.Context, team *models.Team) error { return f.err }
+
+func (f *fakeServices) GetTeam(ctx context.Context, name string) (*models.Team, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.Team{TeamName: name, Members: []models.TeamMember{{UserID: 1, Username: "alice", IsActive: true}}}, nil
+}
+
+func (f *fakeServices) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, limit *int) error {
+	return f.err
+}
+
+func (f *fakeServices) SetIsActive(ctx context.Context, userID int, isActive bool) (*models.User, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.User{ID: userID, Username: "alice", TeamName: "backend", IsActive: isActive}, nil
+}
+
+func (f *fakeServices) GetReview(ctx context.Context, userID int, filter models.PRListFilter) (*models.PRListPage, error) {
+	return f.ListPRs(ctx, filter)
+}
+
+func (f *fakeServices) AddUnavailability(ctx context.Context, u *models.Unavailability) error {
+	u.ID = 1
+	return f.err
+}
+
+func (f *fakeServices) ListUnavailability(ctx context.Context, userID int) ([]models.Unavailability, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []models.Unavailability{{ID: 1, UserID: userID, StartsAt: testTime, EndsAt: testTime.Add(24 * time.Hour), Reason: "vacation"}}, nil
+}
+
+func (f *fakeServices) DeleteUnavailability(ctx context.Context, id int) error { return f.err }
+
+func (f *fakeServices) SetSchedule(ctx context.Context, sch *models.WorkSchedule) error { return f.err }
+
+func (f *fakeServices) GetSchedule(ctx context.Context, userID int) (*models.WorkSchedule, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.WorkSchedule{UserID: userID, TimeZone: "Europe/Moscow", WorkStart: "10:00", WorkEnd: "19:00", WorkDays: []int{1, 2, 3, 4, 5}}, nil
+}
+
+func (f *fakeServices) SetMaxOpenReviews(ctx context.Context, userID int, limit *int) error {
+	return f.err
+}
+
+func (f *fakeServices) SetEmail(ctx context.Context, userID int, email string) error { return f.err }
+
+func (f *fakeServices) CreatePR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string) (*models.PullRequest, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	pr := f.pr(10)
+	pr.Title, pr.AuthorID = title, authorID
+	return pr, nil
+}
+
+func (f *fakeServices) MergePR(ctx context.Context, prID int) (*models.PullRequest, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	pr := f.pr(prID)
+	mergedAt := testTime.Add(time.Hour)
+	pr.Status, pr.MergedAt = "MERGED", &mergedAt
+	return pr, nil
+}
+
+func (f *fakeServices) ReassignReviewer(ctx context.Context, prID int, oldReviewerID int) (*models.PullRequest, int, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	return f.pr(prID), 4, nil
+}
+
+func (f *fakeServices) ListUnderstaffed(ctx context.Context, teamName string) ([]models.PullRequest, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	pr := f.pr(11)
+	pr.Understaffed = true
+	return []models.PullRequest{*pr}, nil
+}
+
+func (f *fakeServices) ListPRs(ctx context.Context, filter models.PRListFilter) (*models.PRListPage, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.PRListPage{PullRequests: []models.PullRequest{*f.pr(10)}, NextCursor: "next"}, nil
+}
+
+func (f *fakeServices) GetPRDetails(ctx context.Context, prID int) (*models.PRDetails, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.PRDetails{
+		PullRequest: *f.pr(prID),
+		TeamName:    "backend",
+		Reviewers:   []models.ReviewerInfo{{UserID: 2, Username: "bob", AssignedAt: testTime}},
+		History:     []models.AssignmentEvent{{ReviewerID: 2, Username: "bob", Action: "ASSIGNED", Reason: "CREATED", At: testTime}},
+	}, nil
+}
+
+func (f *fakeServices) Ready(ctx context.Context) (bool, map[string]services.Check) {
+	return true, map[string]services.Check{"database": {OK: true}}
+}
+
+func (f *fakeServices) SetTeamSLA(ctx context.Context, sla *models.TeamSLA) error {
+	if sla.Policy == "" {
+		sla.Policy = "ESCALATE"
+	}
+	return f.err
+}
+
+func (f *fakeServices) GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.TeamSLA{TeamName: teamName, FirstResponseHours: 8, Policy: "REASSIGN"}, nil
+}
+
+func (f *fakeServices) AddRule(ctx context.Context, rule *models.OwnershipRule) error {
+	rule.ID, rule.Position = 1, 1
+	return f.err
+}
+
+func (f *fakeServices) ListRules(ctx context.Context, teamName string) ([]models.OwnershipRule, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []models.OwnershipRule{{ID: 1, TeamName: teamName, Position: 1, Pattern: "*.go", UserIDs: []int{1}}}, nil
+}
+
+func (f *fakeServices) DeleteRule(ctx context.Context, id int) error { return f.err }
+
+func (f *fakeServices) AddHandleMapping(ctx context.Context, m *models.HandleMapping) error {
+	return f.err
+}
+
+func (f *fakeServices) ListHandleMappings(ctx context.Context) ([]models.HandleMapping, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []models.HandleMapping{{Handle: "@alice", UserID: 1}, {Handle: "@acme/backend", TeamName: "backend"}}, nil
+}
+
+func (f *fakeServices) DeleteHandleMapping(ctx context.Context, handle string) error { return f.err }
+
+func (f *fakeServices) ImportCodeowners(ctx context.Context, teamName, content string) (*models.CodeownersImportReport, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.CodeownersImportReport{TeamName: teamName, ImportedRules: 1, UnknownHandles: []string{"@carol"},
+		Problems: []models.ImportLineError{{Line: 2, Message: "no owners"}}}, nil
+}
+
+func (f *fakeServices) CreateKey(ctx context.Context, name, role string) (string, *models.APIKey, error) {
+	if f.err != nil {
+		return "", nil, f.err
+	}
+	return "prs_secret", &models.APIKey{ID: 5, Name: name, Role: role, CreatedAt: testTime}, nil
+}
+
+func (f *fakeServices) ListKeys(ctx context.Context) ([]models.APIKey, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	revokedAt := testTime.Add(time.Hour)
+	return []models.APIKey{{ID: 5, Name: "ci", Role: "bot", CreatedAt: testTime, RevokedAt: &revokedAt}}, nil
+}
+
+func (f *fakeServices) RevokeKey(ctx context.Context, id int) error { return f.err }
+
+func (f *fakeServices) Export(ctx context.Context) (*models.BulkData, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.BulkData{
+		Teams:        []models.BulkTeam{{TeamName: "backend"}},
+		Users:        []models.BulkUser{{UserID: 1, Username: "alice", IsActive: true}},
+		Members:      []models.BulkMember{{TeamName: "backend", UserID: 1}},
+		PullRequests: []models.BulkPR{{ID: 10, Title: "Add search", AuthorID: 1, TeamName: "backend", Status: "OPEN", CreatedAt: testTime}},
+		Assignments:  []models.BulkAssignment{{PRID: 10, ReviewerID: 1, AssignedAt: testTime}},
+	}, nil
+}
+
+func (f *fakeServices) Import(ctx context.Context, data *models.BulkData, dryRun bool) (*models.ImportResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.ImportResult{
+		DryRun:  dryRun,
+		Summary: map[string]*models.ImportCounts{"users": {Created: len(data.Users)}},
+		Changes: []models.ImportChange{{Entity: "users", Key: "1", Action: "CREATE", After: map[string]any{"username": "alice"}}},
+	}, nil
+}
+
+func (f *fakeServices) scimUser(id int) *models.ScimUser {
+	return &models.ScimUser{ID: id, ExternalID: "okta-1", UserName: "alice", Active: true, Groups: []models.ScimRef{{ID: 1, Display: "backend"}}}
+}
+
+func (f *fakeServices) scimGroup(id int) *models.ScimGroup {
+	return &models.ScimGroup{ID: id, DisplayName: "backend", Members: []models.ScimRef{{ID: 1, Display: "alice"}}}
+}
+
+func (f *fakeServices) GetUser(ctx context.Context, id int) (*models.ScimUser, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.scimUser(id), nil
+}
+
+func (f *fakeServices) ListUsers(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimUser, int, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	return []models.ScimUser{*f.scimUser(1)}, 1, nil
+}
+
+func (f *fakeServices) CreateUser(ctx context.Context, u *models.ScimUser) error {
+	u.ID = 7
+	return f.err
+}
+
+func (f *fakeServices) ReplaceUser(ctx context.Context, u *models.ScimUser) (*models.ScimUser, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return u, nil
+}
+
+func (f *fakeServices) PatchUser(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimUser, error) {
+	return f.GetUser(ctx, id)
+}
+
+func (f *fakeServices) DeleteUser(ctx context.Context, id int) error { return f.err }
+
+func (f *fakeServices) GetGroup(ctx context.Context, id int) (*models.ScimGroup, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.scimGroup(id), nil
+}
+
+func (f *fakeServices) ListGroups(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimGroup, int, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	return []models.ScimGroup{*f.scimGroup(1)}, 1, nil
+}
+
+func (f *fakeServices) CreateGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	g.ID = 3
+	return g, nil
+}
+
+func (f *fakeServices) ReplaceGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return g, nil
+}
+
+func (f *fakeServices) PatchGroup(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimGroup, error) {
+	return f.GetGroup(ctx, id)
+}
+
+func (f *fakeServices) DeleteGroup(ctx context.Context, id int) error { return f.err }
+
+func (f *fakeServices) SetTeamWebhook(ctx context.Context, teamName, webhookURL string) error {
+	return f.err
+}
+
+func (f *fakeServices) SetUserNotifications(ctx context.Context, userID int, enabled bool) error {
+	return f.err
+}
+
+func (f *fakeServices) Execute(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Result {
+	return &graphql.Result{Data: map[string]interface{}{"team": map[string]interface{}{"name": "backend"}}}
+}
+
+func (f *fakeServices) HandleMergeRequest(ctx context.Context, ev *gitlab.MergeRequestEvent) (string, int, error) {
+	if f.err != nil {
+		return "", 0, f.err
+	}
+	return "created", 10, nil
+}
+
+// newTestServer - операции с фейковыми сервисами и validator в режиме strict: ответ не по спецификации - 500
+func newTestServer(t *testing.T, fake *fakeServices) *Server {
+	t.Helper()
+	v, err := newValidator(ResponseValidationStrict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Server{
+		teams: fake, users: fake, prs: fake, health: fake, sla: fake, codeOwners: fake, apiKeys: fake,
+		bulk: fake, scim: fake, notifications: fake, graphql: fake, gitlab: fake,
+		gitlabSecret: testWebhookSecret,
+		validator:    v,
+	}
+}
+
+// testRouter - маршруты сервера за middleware.Authenticate, как в cmd/app
+func testRouter(s *Server) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.Authenticate(fakeAuth{}))
+	s.Register(r)
+	return r
+}
+
+type apiCase struct {
+	name        string
+	key         string
+	method      string
+	target      string
+	contentType string
+	body        string
+	header      map[string]string
+	status      int
+}
+
+func (c apiCase) request() *http.Request {
+	req := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+	if c.body != "" {
+		ct := c.contentType
+		if ct == "" {
+			ct = "application/json"
+		}
+		req.Header.Set("Content-Type", ct)
+	}
+	if c.key != "" {
+		req.Header.Set("X-API-Key", c.key)
+	}
+	for k, v := range c.header {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func (c apiCase) run(t *testing.T, h http.Handler) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, c.request())
+	if rec.Code != c.status {
+		t.Fatalf("%s %s: status = %d, want %d: %s", c.method, c.target, rec.Code, c.status, rec.Body)
+	}
+	return rec
+}
+
+const mrOpen = `{"object_kind":"merge_request","project":{"id":42},"object_attributes":{"iid":7,"action":"open"}}`
+
+// Успешный вызов каждой операции: и запрос, и ответ проходят проверку по openapi.yml
+var successCases = []apiCase{
+	{"add team", adminKey, "POST", "/team/add", "", `{"team_name":"backend","members":[{"user_id":"u1","username":"alice","is_active":true}]}`, nil, 201},
+	{"get team", readerKey, "GET", "/team/get?team_name=backend", "", "", nil, 200},
+	{"set team default max open reviews", adminKey, "POST", "/team/setDefaultMaxOpenReviews", "", `{"team_name":"backend","default_max_open_reviews":3}`, nil, 200},
+	{"set team SLA", adminKey, "POST", "/team/setSLA", "", `{"team_name":"backend","first_response_hours":8}`, nil, 200},
+	{"get team SLA", readerKey, "GET", "/team/getSLA?team_name=backend", "", "", nil, 200},
+	{"set notification webhook", adminKey, "POST", "/team/setNotificationWebhook", "", `{"team_name":"backend","url":"https://hooks.example.com/x"}`, nil, 200},
+	{"add ownership rule", adminKey, "POST", "/team/addOwnershipRule", "", `{"team_name":"backend","pattern":"*.go","user_ids":["u1"]}`, nil, 201},
+	{"list ownership rules", readerKey, "GET", "/team/ownershipRules?team_name=backend", "", "", nil, 200},
+	{"delete ownership rule", adminKey, "POST", "/team/deleteOwnershipRule", "", `{"id":1}`, nil, 200},
+	{"import CODEOWNERS", adminKey, "POST", "/team/importCodeowners?team_name=backend", "text/plain", "*.go @alice\n", nil, 200},
+	{"add handle mapping", adminKey, "POST", "/team/addHandleMapping", "", `{"handle":"@alice","user_id":"u1"}`, nil, 201},
+	{"list handle mappings", readerKey, "GET", "/team/handleMappings", "", "", nil, 200},
+	{"delete handle mapping", adminKey, "POST", "/team/deleteHandleMapping", "", `{"handle":"@alice"}`, nil, 200},
+	{"set user active", adminKey, "POST", "/users/setIsActive", "", `{"user_id":"u1","is_active":false}`, nil, 200},
+	{"create PR", botKey, "POST", "/pullRequest/create", "", `{"pull_request_name":"Add search","author_id":"u1","team_id":1}`, nil, 201},
+	{"merge PR", botKey, "POST", "/pullRequest/merge", "", `{"pull_request_id":"pr-10"}`, nil, 200},
+	{"get PR", readerKey, "GET", "/pullRequest/get?pull_request_id=pr-10", "", "", nil, 200},
+	{"list PRs", readerKey, "GET", "/pullRequest/list?status=OPEN&sort=-created_at&limit=10", "", "", nil, 200},
+	{"reassign own review", reviewerKey, "POST", "/pullRequest/reassign", "", `{"pull_request_id":"pr-10","old_user_id":"u1"}`, nil, 200},
+	{"get own review", reviewerKey, "GET", "/users/getReview", "", "", nil, 200},
+	{"set user max open reviews", adminKey, "POST", "/users/setMaxOpenReviews", "", `{"user_id":"u1","max_open_reviews":2}`, nil, 200},
+	{"set own email", reviewerKey, "POST", "/users/setEmail", "", `{"user_id":"u1","email":"alice@example.com"}`, nil, 200},
+	{"set own notifications", reviewerKey, "POST", "/users/setNotifications", "", `{"user_id":"u1","enabled":false}`, nil, 200},
+	{"add unavailability", adminKey, "POST", "/users/availability/add", "", `{"user_id":"u1","starts_at":"2025-01-02T00:00:00Z","ends_at":"2025-01-03T00:00:00Z"}`, nil, 201},
+	{"list unavailability", readerKey, "GET", "/users/availability/list?user_id=u1", "", "", nil, 200},
+	{"delete unavailability", adminKey, "POST", "/users/availability/delete", "", `{"id":1}`, nil, 200},
+	{"set schedule", adminKey, "POST", "/users/setSchedule", "", `{"user_id":"u1","time_zone":"Europe/Moscow","work_start":"10:00","work_end":"19:00","work_days":[1,2,3,4,5]}`, nil, 200},
+	{"get schedule", readerKey, "GET", "/users/getSchedule?user_id=u1", "", "", nil, 200},
+	{"list understaffed PRs", readerKey, "GET", "/pullRequest/understaffed?team_name=backend", "", "", nil, 200},
+	{"liveness", "", "GET", "/health/live", "", "", nil, 200},
+	{"readiness", "", "GET", "/health/ready", "", "", nil, 200},
+	{"metrics", "", "GET", "/metrics", "", "", nil, 200},
+	{"get log level", adminKey, "GET", "/admin/logLevel", "", "", nil, 200},
+	{"set log level", adminKey, "POST", "/admin/logLevel", "", `{"level":"info"}`, nil, 200},
+	{"create API key", adminKey, "POST", "/admin/apiKeys/create", "", `{"name":"ci","role":"bot"}`, nil, 201},
+	{"list API keys", adminKey, "GET", "/admin/apiKeys/list", "", "", nil, 200},
+	{"revoke API key", adminKey, "POST", "/admin/apiKeys/revoke", "", `{"id":5}`, nil, 200},
+	{"export JSON", adminKey, "GET", "/admin/export", "", "", nil, 200},
+	{"export CSV", adminKey, "GET", "/admin/export?format=csv&entity=prs", "", "", nil, 200},
+	{"import JSON", adminKey, "POST", "/admin/import?dry_run=true", "", `{"users":[{"user_id":1,"username":"alice","is_active":true}]}`, nil, 200},
+	{"import CSV", adminKey, "POST", "/admin/import?entity=users", "text/csv", "user_id,username,is_active\n1,alice,true\n", nil, 200},
+	{"GraphQL GET", readerKey, "GET", `/graphql?query={team(name:"backend"){name}}&variables={"a":1}`, "", "", nil, 200},
+	{"GraphQL POST", readerKey, "POST", "/graphql", "", `{"query":"{ team(name: \"backend\") { name } }"}`, nil, 200},
+	{"GitLab webhook", "", "POST", "/webhooks/gitlab", "", mrOpen,
+		map[string]string{"X-Gitlab-Token": testWebhookSecret, "X-Gitlab-Event": gitlab.MergeRequestEventHeader}, 200},
+	{"SCIM config", adminKey, "GET", "/scim/v2/ServiceProviderConfig", "", "", nil, 200},
+	{"SCIM list users", adminKey, "GET", `/scim/v2/Users?filter=userName+eq+"alice"&startIndex=1&count=10`, "", "", nil, 200},
+	{"SCIM create user", adminKey, "POST", "/scim/v2/Users", "application/scim+json", `{"userName":"alice","externalId":"okta-1","active":"True"}`, nil, 201},
+	{"SCIM get user", adminKey, "GET", "/scim/v2/Users/1", "", "", nil, 200},
+	{"SCIM replace user", adminKey, "PUT", "/scim/v2/Users/1", "application/scim+json", `{"userName":"alice","active":false}`, nil, 200},
+	{"SCIM patch user", adminKey, "PATCH", "/scim/v2/Users/1", "application/scim+json", `{"Operations":[{"op":"replace","path":"active","value":false}]}`, nil, 200},
+	{"SCIM delete user", adminKey, "DELETE", "/scim/v2/Users/1", "", "", nil, 204},
+	{"SCIM list groups", adminKey, "GET", "/scim/v2/Groups", "", "", nil, 200},
+	{"SCIM create group", adminKey, "POST", "/scim/v2/Groups", "application/json", `{"displayName":"backend","members":[{"value":"1"}]}`, nil, 201},
+	{"SCIM get group", adminKey, "GET", "/scim/v2/Groups/1", "", "", nil, 200},
+	{"SCIM replace group", adminKey, "PUT", "/scim/v2/Groups/1", "application/scim+json", `{"displayName":"backend","members":[]}`, nil, 200},
+	{"SCIM patch group", adminKey, "PATCH", "/scim/v2/Groups/1", "application/scim+json", `{"Operations":[{"op":"add","path":"members","value":[{"value":"2"}]}]}`, nil, 200},
+	{"SCIM delete group", adminKey, "DELETE", "/scim/v2/Groups/1", "", "", nil, 204},
+}
+
+func TestOperations(t *testing.T) {
+	s := newTestServer(t, &fakeServices{})
+	h, v := testRouter(s), s.validator
+
+	covered := map[string]bool{}
+	for _, tc := range successCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.run(t, h)
+		})
+		route, _, err := v.router.FindRoute(tc.request())
+		if err != nil {
+			t.Fatalf("%s %s is not in openapi.yml: %v", tc.method, tc.target, err)
+		}
+		covered[route.Operation.OperationID] = true
+	}
+
+	// Новая операция в openapi.yml должна появиться и в этом тесте
+	for op := range v.operations {
+		if !covered[op.OperationID] {
+			t.Errorf("operation %s has no successful test case", op.OperationID)
+		}
+	}
+}
+
+func TestOperationErrors(t *testing.T) {
+	tests := []struct {
+		apiCase
+		err     error  // ошибка сервисов
+		code    string // error.code
+		message string // error.message, если важно
+	}{
+		{apiCase{"no key", "", "GET", "/team/get?team_name=backend", "", "", nil, 401}, nil, "UNAUTHORIZED", ""},
+		{apiCase{"reader creates PR", readerKey, "POST", "/pullRequest/create", "", `{"pull_request_name":"x","author_id":"u1","team_id":1}`, nil, 403}, nil, "FORBIDDEN", ""},
+		{apiCase{"reviewer reassigns someone else", reviewerKey, "POST", "/pullRequest/reassign", "", `{"pull_request_id":"pr-10","old_user_id":"u2"}`, nil, 403}, nil, "FORBIDDEN", ""},
+		{apiCase{"reviewer sets someone else's email", reviewerKey, "POST", "/users/setEmail", "", `{"user_id":"u2","email":"bob@example.com"}`, nil, 403}, nil, "FORBIDDEN", ""},
+		{apiCase{"numeric user_id", adminKey, "POST", "/users/setMaxOpenReviews", "", `{"user_id":1}`, nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"user not found", readerKey, "GET", "/users/getSchedule?user_id=3", "", "", nil, 404}, errors.New("not found"), "NOT_FOUND", "user u3 not found"},
+		{apiCase{"unknown field", adminKey, "POST", "/team/deleteOwnershipRule", "", `{"id":1,"force":true}`, nil, 400}, nil, "BAD_REQUEST", "force: unknown field"},
+		{apiCase{"malformed JSON", adminKey, "POST", "/team/deleteOwnershipRule", "", `{"id":`, nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"not found", readerKey, "GET", "/team/get?team_name=backend", "", "", nil, 404}, errors.New("not found"), "NOT_FOUND", "team 'backend' not found"},
+		{apiCase{"not found: what", adminKey, "POST", "/team/addOwnershipRule", "", `{"team_name":"backend","pattern":"*","owner_teams":["qa"]}`, nil, 404},
+			errors.New("not found: team 'qa'"), "NOT_FOUND", "team 'qa' not found"},
+		{apiCase{"service code", botKey, "POST", "/pullRequest/reassign", "", `{"pull_request_id":"pr-10","old_user_id":"u1"}`, nil, 409},
+			errors.New("NO_CANDIDATE: no active replacement candidate in team"), "NO_CANDIDATE", ""},
+		{apiCase{"internal", adminKey, "GET", "/admin/apiKeys/list", "", "", nil, 500}, errors.New("connection refused"), "INTERNAL", ""},
+		{apiCase{"bad log level", adminKey, "POST", "/admin/logLevel", "", `{"level":"loud"}`, nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"CSV export without entity", adminKey, "GET", "/admin/export?format=csv", "", "", nil, 400}, nil, "BAD_REQUEST", "entity: is required for CSV"},
+		{apiCase{"format mismatch", adminKey, "POST", "/admin/import?format=csv&entity=users", "", `{}`, nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"bad CSV", adminKey, "POST", "/admin/import?entity=users", "text/csv", "user_id,username\n1,alice\n", nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"GraphQL bad variables", readerKey, "GET", "/graphql?query={x}&variables={", "", "", nil, 400}, nil, "BAD_REQUEST", ""},
+		{apiCase{"GraphQL oversized query", readerKey, "POST", "/graphql", "", `{"query":"` + strings.Repeat("x", 64<<10) + `"}`, nil, 413}, nil, "PAYLOAD_TOO_LARGE", ""},
+		{apiCase{"oversized body", adminKey, "POST", "/team/deleteOwnershipRule", "", `{"id":1,"x":"` + strings.Repeat("x", maxBodyBytes) + `"}`, nil, 413},
+			nil, "PAYLOAD_TOO_LARGE", "request body must not exceed 1048576 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tt.run(t, testRouter(newTestServer(t, &fakeServices{err: tt.err})))
+			var resp models.ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response %q: %v", rec.Body, err)
+			}
+			if resp.Error.Code != tt.code {
+				t.Errorf("code = %s, want %s", resp.Error.Code, tt.code)
+			}
+			if tt.message != "" && resp.Error.Message != tt.message {
+				t.Errorf("message = %q, want %q", resp.Error.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestSCIMErrors(t *testing.T) {
+	tests := []struct {
+		apiCase
+		err      error
+		scimType string
+	}{
+		{apiCase{"unsupported filter", adminKey, "GET", `/scim/v2/Users?filter=userName+sw+"a"`, "", "", nil, 400}, nil, "invalidFilter"},
+		{apiCase{"malformed JSON", adminKey, "POST", "/scim/v2/Users", "application/scim+json", `{"userName":`, nil, 400}, nil, "invalidSyntax"},
+		{apiCase{"bad active", adminKey, "POST", "/scim/v2/Users", "application/scim+json", `{"userName":"alice","active":"maybe"}`, nil, 400}, nil, "invalidValue"},
+		{apiCase{"bad member id", adminKey, "POST", "/scim/v2/Groups", "application/scim+json", `{"displayName":"qa","members":[{"value":"x"}]}`, nil, 400}, nil, "invalidValue"},
+		{apiCase{"oversized body", adminKey, "POST", "/scim/v2/Users", "application/scim+json", `{"userName":"` + strings.Repeat("x", maxBodyBytes) + `"}`, nil, 413}, nil, ""},
+		{apiCase{"bad id", adminKey, "GET", "/scim/v2/Users/abc", "", "", nil, 404}, nil, ""},
+		{apiCase{"not found", adminKey, "GET", "/scim/v2/Groups/9", "", "", nil, 404}, errors.New("not found"), ""},
+		{apiCase{"conflict", adminKey, "POST", "/scim/v2/Users", "application/scim+json", `{"userName":"alice"}`, nil, 409}, errors.New("CONFLICT: userName is taken"), "uniqueness"},
+		{apiCase{"bad patch", adminKey, "PATCH", "/scim/v2/Users/1", "application/scim+json", `{"Operations":[{"op":"move"}]}`, nil, 400},
+			errors.New(`BAD_REQUEST: unsupported patch op "move"`), "invalidValue"},
+		{apiCase{"internal", adminKey, "DELETE", "/scim/v2/Groups/1", "", "", nil, 500}, errors.New("connection refused"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tt.run(t, testRouter(newTestServer(t, &fakeServices{err: tt.err})))
+			if ct := rec.Header().Get("Content-Type"); ct != "application/scim+json" {
+				t.Errorf("Content-Type = %q, want application/scim+json", ct)
+			}
+			var resp struct {
+				Schemas  []string `json:"schemas"`
+				Status   string   `json:"status"`
+				ScimType string   `json:"scimType"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response %q: %v", rec.Body, err)
+			}
+			if len(resp.Schemas) != 1 || resp.Schemas[0] != models.ScimErrorSchema {
+				t.Errorf("schemas = %v", resp.Schemas)
+			}
+			if resp.ScimType != tt.scimType {
+				t.Errorf("scimType = %q, want %q", resp.ScimType, tt.scimType)
+			}
+		})
+	}
+}
+
+// Маршрут, который chi сопоставил, а спецификация нет, не доходит до операции даже без ключа
+func TestRouteNotInSpec(t *testing.T) {
+	h := testRouter(newTestServer(t, &fakeServices{}))
+	for _, method := range []string{"GET", "DELETE"} {
+		rec := apiCase{"escaped id", "", method, "/scim/v2/Users/1%2Fx", "", "", nil, 404}.run(t, h)
+		var resp struct {
+			Detail string `json:"detail"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response %q: %v", rec.Body, err)
+		}
+		if resp.Detail != "resource not found" {
+			t.Errorf("%s: detail = %q, want the validator's 404", method, resp.Detail)
+		}
+	}
+}
+
+func TestGitLabWebhook(t *testing.T) {
+	mrUpdate := `{"object_kind":"merge_request","project":{"id":42},"object_attributes":{"iid":7,"action":"update"}}`
+	tests := []struct {
+		name   string
+		secret string // секрет сервиса; пустой - интеграция выключена
+		token  string
+		event  string
+		body   string
+		status int
+		code   string // error.code для ошибок, status для 200
+	}{
+		{"disabled", "", "", gitlab.MergeRequestEventHeader, mrUpdate, http.StatusNotFound, "NOT_FOUND"},
+		{"missing token", testWebhookSecret, "", gitlab.MergeRequestEventHeader, mrUpdate, http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"wrong token", testWebhookSecret, "guess", gitlab.MergeRequestEventHeader, mrUpdate, http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"token prefix", testWebhookSecret, testWebhookSecret[:5], gitlab.MergeRequestEventHeader, mrUpdate, http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"other event", testWebhookSecret, testWebhookSecret, "Push Hook", `{}`, http.StatusOK, "ignored"},
+		{"ignored action", testWebhookSecret, testWebhookSecret, gitlab.MergeRequestEventHeader, mrUpdate, http.StatusOK, "ignored"},
+		{"malformed body", testWebhookSecret, testWebhookSecret, gitlab.MergeRequestEventHeader, `{"object_kind":`, http.StatusBadRequest, "BAD_REQUEST"},
+		{"oversized body", testWebhookSecret, testWebhookSecret, gitlab.MergeRequestEventHeader,
+			`{"description":"` + strings.Repeat("x", 4<<20) + `"}`, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, &fakeServices{})
+			// Сюда доходят только события, которые сервис отбрасывает до обращения к БД и GitLab
+			s.gitlab, s.gitlabSecret = services.NewGitLabService(nil, nil, nil), tt.secret
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.token != "" {
+				req.Header.Set("X-Gitlab-Token", tt.token)
+			}
+			req.Header.Set("X-Gitlab-Event", tt.event)
+			rec := httptest.NewRecorder()
+			testRouter(s).ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.status, rec.Body)
+			}
+			var resp struct {
+				Status string `json:"status"`
+				Error  struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response %q: %v", rec.Body, err)
+			}
+			if got := resp.Status + resp.Error.Code; got != tt.code {
+				t.Errorf("response %s, want %s", rec.Body, tt.code)
+			}
+		})
+	}
+}
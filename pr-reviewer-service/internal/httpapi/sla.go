@@ -0,0 +1,33 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) SetTeamSLA(ctx context.Context, request openapi.SetTeamSLARequestObject) (openapi.SetTeamSLAResponseObject, error) {
+	body := request.Body
+	sla := &models.TeamSLA{TeamName: body.TeamName, FirstResponseHours: body.FirstResponseHours, Policy: string(deref(body.Policy))}
+	if err := s.sla.SetTeamSLA(ctx, sla); err != nil {
+		logger.FromContext(ctx).Error("Failed to set team SLA", zap.Error(err), zap.String("team_name", sla.TeamName))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", sla.TeamName))
+	}
+
+	logger.FromContext(ctx).Info("Team SLA updated", zap.String("team_name", sla.TeamName))
+	return openapi.SetTeamSLA200JSONResponse{Sla: toTeamSLA(sla)}, nil
+}
+
+func (s *Server) GetTeamSLA(ctx context.Context, request openapi.GetTeamSLARequestObject) (openapi.GetTeamSLAResponseObject, error) {
+	name := request.Params.TeamName
+	sla, err := s.sla.GetTeamSLA(ctx, name)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Team SLA not found", zap.String("team_name", name), zap.Error(err))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("SLA for team '%s' not found", name))
+	}
+	return openapi.GetTeamSLA200JSONResponse{Sla: toTeamSLA(sla)}, nil
+}
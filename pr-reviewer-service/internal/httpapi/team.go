@@ -0,0 +1,54 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) AddTeam(ctx context.Context, request openapi.AddTeamRequestObject) (openapi.AddTeamResponseObject, error) {
+	team, err := fromTeam(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.teams.AddTeam(ctx, team); err != nil {
+		logger.FromContext(ctx).Error("Failed to add team", zap.Error(err), zap.String("team_name", team.TeamName))
+		var v *models.ValidationError
+		if errors.As(err, &v) {
+			return nil, validationError(v)
+		}
+		return nil, newError("TEAM_EXISTS", err.Error())
+	}
+
+	logger.FromContext(ctx).Info("Added new team", zap.String("team_name", team.TeamName))
+	return openapi.AddTeam201JSONResponse{Team: toTeam(team)}, nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, request openapi.GetTeamRequestObject) (openapi.GetTeamResponseObject, error) {
+	name := request.Params.TeamName
+	team, err := s.teams.GetTeam(ctx, name)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Team not found", zap.String("team_name", name), zap.Error(err))
+		return nil, newError("NOT_FOUND", fmt.Sprintf("team '%s' not found", name))
+	}
+
+	logger.FromContext(ctx).Info("Fetched team", zap.String("team_name", name))
+	return openapi.GetTeam200JSONResponse(toTeam(team)), nil
+}
+
+// SetTeamDefaultMaxOpenReviews - лимит открытых ревью для участников без своего лимита; null снимает его
+func (s *Server) SetTeamDefaultMaxOpenReviews(ctx context.Context, request openapi.SetTeamDefaultMaxOpenReviewsRequestObject) (openapi.SetTeamDefaultMaxOpenReviewsResponseObject, error) {
+	body := request.Body
+	if err := s.teams.SetDefaultMaxOpenReviews(ctx, body.TeamName, body.DefaultMaxOpenReviews); err != nil {
+		logger.FromContext(ctx).Error("Failed to set team review capacity", zap.Error(err), zap.String("team_name", body.TeamName))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("team '%s' not found", body.TeamName))
+	}
+
+	logger.FromContext(ctx).Info("Team review capacity updated", zap.String("team_name", body.TeamName))
+	return openapi.SetTeamDefaultMaxOpenReviews200JSONResponse{TeamName: body.TeamName, DefaultMaxOpenReviews: body.DefaultMaxOpenReviews}, nil
+}
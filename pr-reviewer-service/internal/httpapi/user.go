@@ -0,0 +1,185 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) SetUserIsActive(ctx context.Context, request openapi.SetUserIsActiveRequestObject) (openapi.SetUserIsActiveResponseObject, error) {
+	id, err := parseID("user_id", request.Body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.users.SetIsActive(ctx, id, request.Body.IsActive)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to set user active status", zap.Error(err), zap.Int("user_id", id), zap.Bool("is_active", request.Body.IsActive))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("User active status updated", zap.Int("user_id", user.ID), zap.Bool("is_active", user.IsActive))
+	return openapi.SetUserIsActive200JSONResponse{User: toUser(user)}, nil
+}
+
+func (s *Server) GetUserReview(ctx context.Context, request openapi.GetUserReviewRequestObject) (openapi.GetUserReviewResponseObject, error) {
+	params := request.Params
+	value := deref(params.UserId)
+	// Без user_id (или с "me") - ревью текущего пользователя из JWT
+	if value == "" || value == "me" {
+		p := middleware.PrincipalFrom(ctx)
+		if p == nil || p.UserID == 0 {
+			return nil, newError("BAD_REQUEST", "user_id is required for API keys")
+		}
+		value = userID(p.UserID)
+	}
+	id, err := parseID("user_id", value, "u")
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.users.GetReview(ctx, id, listFilter(params.Status, string(deref(params.Sort)), params.Limit, params.Cursor))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get assigned PRs", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	resp := openapi.GetUserReview200JSONResponse{
+		UserId:       userID(id),
+		PullRequests: make([]openapi.PullRequestShort, 0, len(page.PullRequests)),
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, openapi.PullRequestShort{
+			PullRequestId:   prID(pr.ID),
+			PullRequestName: pr.Title,
+			AuthorId:        userID(pr.AuthorID),
+			Status:          openapi.PullRequestStatus(pr.Status),
+		})
+	}
+	if page.NextCursor != "" {
+		resp.NextCursor = &page.NextCursor
+	}
+	logger.FromContext(ctx).Info("Retrieved assigned PRs for user", zap.Int("user_id", id), zap.Int("count", len(page.PullRequests)))
+	return resp, nil
+}
+
+func (s *Server) AddUserUnavailability(ctx context.Context, request openapi.AddUserUnavailabilityRequestObject) (openapi.AddUserUnavailabilityResponseObject, error) {
+	body := request.Body
+	id, err := parseID("user_id", body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	period := &models.Unavailability{UserID: id, StartsAt: body.StartsAt, EndsAt: body.EndsAt, Reason: deref(body.Reason)}
+	if err := s.users.AddUnavailability(ctx, period); err != nil {
+		logger.FromContext(ctx).Error("Failed to add unavailability", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("User unavailability added", zap.Int("user_id", period.UserID), zap.Int("id", period.ID))
+	return openapi.AddUserUnavailability201JSONResponse{Availability: toUnavailability(period)}, nil
+}
+
+func (s *Server) ListUserUnavailability(ctx context.Context, request openapi.ListUserUnavailabilityRequestObject) (openapi.ListUserUnavailabilityResponseObject, error) {
+	id, err := parseID("user_id", request.Params.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	periods, err := s.users.ListUnavailability(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list unavailability", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	resp := openapi.ListUserUnavailability200JSONResponse{UserId: userID(id), Availability: make([]openapi.Unavailability, 0, len(periods))}
+	for i := range periods {
+		resp.Availability = append(resp.Availability, toUnavailability(&periods[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteUserUnavailability(ctx context.Context, request openapi.DeleteUserUnavailabilityRequestObject) (openapi.DeleteUserUnavailabilityResponseObject, error) {
+	id := request.Body.Id
+	if err := s.users.DeleteUnavailability(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("Failed to delete unavailability", zap.Error(err), zap.Int("id", id))
+		return nil, serviceError(err, "INTERNAL", fmt.Sprintf("availability period with id %d not found", id))
+	}
+
+	logger.FromContext(ctx).Info("User unavailability deleted", zap.Int("id", id))
+	return openapi.DeleteUserUnavailability200JSONResponse{Deleted: id}, nil
+}
+
+// SetUserSchedule - незаполненные поля берутся из расписания по умолчанию
+func (s *Server) SetUserSchedule(ctx context.Context, request openapi.SetUserScheduleRequestObject) (openapi.SetUserScheduleResponseObject, error) {
+	body := request.Body
+	id, err := parseID("user_id", body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	sch := &models.WorkSchedule{
+		UserID:    id,
+		TimeZone:  deref(body.TimeZone),
+		WorkStart: deref(body.WorkStart),
+		WorkEnd:   deref(body.WorkEnd),
+		WorkDays:  deref(body.WorkDays),
+	}
+	if err := s.users.SetSchedule(ctx, sch); err != nil {
+		logger.FromContext(ctx).Error("Failed to set user schedule", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("User schedule updated", zap.Int("user_id", sch.UserID), zap.String("time_zone", sch.TimeZone))
+	return openapi.SetUserSchedule200JSONResponse{Schedule: toWorkSchedule(sch)}, nil
+}
+
+func (s *Server) GetUserSchedule(ctx context.Context, request openapi.GetUserScheduleRequestObject) (openapi.GetUserScheduleResponseObject, error) {
+	id, err := parseID("user_id", request.Params.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	sch, err := s.users.GetSchedule(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Warn("User schedule not found", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+	return openapi.GetUserSchedule200JSONResponse{Schedule: toWorkSchedule(sch)}, nil
+}
+
+// SetUserMaxOpenReviews - null снимает личный лимит (действует лимит команды)
+func (s *Server) SetUserMaxOpenReviews(ctx context.Context, request openapi.SetUserMaxOpenReviewsRequestObject) (openapi.SetUserMaxOpenReviewsResponseObject, error) {
+	body := request.Body
+	id, err := parseID("user_id", body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.users.SetMaxOpenReviews(ctx, id, body.MaxOpenReviews); err != nil {
+		logger.FromContext(ctx).Error("Failed to set user review capacity", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	logger.FromContext(ctx).Info("User review capacity updated", zap.Int("user_id", id))
+	return openapi.SetUserMaxOpenReviews200JSONResponse{UserId: userID(id), MaxOpenReviews: body.MaxOpenReviews}, nil
+}
+
+// SetUserEmail - адрес для email-дайджеста; пустой удаляет его
+func (s *Server) SetUserEmail(ctx context.Context, request openapi.SetUserEmailRequestObject) (openapi.SetUserEmailResponseObject, error) {
+	body := request.Body
+	id, err := parseID("user_id", body.UserId, "u")
+	if err != nil {
+		return nil, err
+	}
+	if p := middleware.PrincipalFrom(ctx); p != nil && p.Role == models.RoleReviewer && p.UserID != id {
+		return nil, newError("FORBIDDEN", "reviewers can change only their own email")
+	}
+	if err := s.users.SetEmail(ctx, id, body.Email); err != nil {
+		logger.FromContext(ctx).Warn("Failed to set user email", zap.Error(err), zap.Int("user_id", id))
+		return nil, serviceError(err, "INTERNAL", "user "+userID(id)+" not found")
+	}
+
+	// Адрес - персональные данные, в лог не пишем
+	logger.FromContext(ctx).Info("User email updated", zap.Int("user_id", id), zap.Bool("has_email", body.Email != ""))
+	return openapi.SetUserEmail200JSONResponse{UserId: userID(id), Email: body.Email}, nil
+}
@@ -0,0 +1,303 @@
+package httpapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"pr-reviewer-service/internal/httpapi/openapi"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/middleware"
+	"pr-reviewer-service/internal/models"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"go.uber.org/zap"
+)
+
+// maxBodyBytes - предел тела запроса операций без x-max-body-bytes
+const maxBodyBytes = 1 << 20
+
+// Что делать с ответом, не соответствующим openapi.yml
+const (
+	ResponseValidationOff    = "off"    // не проверять
+	ResponseValidationLog    = "log"    // записать в лог и отдать как есть
+	ResponseValidationStrict = "strict" // записать в лог и вернуть 500
+)
+
+// validator проверяет запросы и ответы операций по openapi.yml, встроенному в
+// сгенерированный код. Перед проверкой запроса - роли из x-roles операции
+type validator struct {
+	router     routers.Router
+	operations map[*openapi3.Operation]operation
+	responses  string
+	options    *openapi3filter.Options
+}
+
+// operation - расширения операции из openapi.yml
+type operation struct {
+	roles   []string // x-roles; nil - доступна без ключа
+	maxBody int64    // x-max-body-bytes или maxBodyBytes
+}
+
+func newValidator(responses string) (*validator, error) {
+	switch responses {
+	case ResponseValidationOff, ResponseValidationLog, ResponseValidationStrict:
+	default:
+		return nil, fmt.Errorf("invalid response validation mode %q: expected off, log or strict", responses)
+	}
+
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+	// Адрес из servers не сравниваем: сервис может работать за прокси
+	spec.Servers = nil
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// SCIM-клиенты присылают application/scim+json - это тот же JSON
+	openapi3filter.RegisterBodyDecoder("application/scim+json", openapi3filter.JSONBodyDecoder)
+
+	v := &validator{
+		router:     router,
+		operations: map[*openapi3.Operation]operation{},
+		responses:  responses,
+		options: &openapi3filter.Options{
+			// Ключ и токен проверяет middleware.Authenticate, роли - x-roles
+			AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+			IncludeResponseStatus: true,
+			MultiError:            true, // все проблемы запроса сразу, а не только первая
+		},
+	}
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			roles, err := operationRoles(op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+			maxBody, err := operationMaxBody(op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+			v.operations[op] = operation{roles: roles, maxBody: maxBody}
+		}
+	}
+	return v, nil
+}
+
+// operationMaxBody - предел тела из x-max-body-bytes (импорт и вебхуки больше обычного)
+func operationMaxBody(op *openapi3.Operation) (int64, error) {
+	raw, ok := op.Extensions["x-max-body-bytes"]
+	if !ok {
+		return maxBodyBytes, nil
+	}
+	n, ok := raw.(float64)
+	if !ok || n < 1 || n != float64(int64(n)) {
+		return 0, fmt.Errorf("x-max-body-bytes must be a positive integer, got %v", raw)
+	}
+	return int64(n), nil
+}
+
+// operationRoles - роли из x-roles; nil - операция доступна без ключа (security: [])
+func operationRoles(op *openapi3.Operation) ([]string, error) {
+	raw, ok := op.Extensions["x-roles"]
+	if !ok {
+		if op.Security != nil && len(*op.Security) == 0 {
+			return nil, nil
+		}
+		return nil, errors.New("x-roles is required for operations with security")
+	}
+	list, ok := raw.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, errors.New("x-roles must be a non-empty list")
+	}
+	roles := make([]string, 0, len(list))
+	for _, item := range list {
+		role, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("x-roles: unexpected value %v", item)
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func (v *validator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			// chi нашёл маршрут, а спецификация - нет (например, %2F в id): ролей не
+			// знаем, поэтому запрещаем, как gRPC для неизвестных методов
+			logger.FromContext(r.Context()).Warn("Route is not in API spec", zap.Error(err), zap.String("path", r.URL.Path))
+			if isSCIM(r) {
+				writeSCIMError(w, &scimError{status: http.StatusNotFound, detail: "resource not found"})
+				return
+			}
+			writeError(w, "NOT_FOUND", "route not found")
+			return
+		}
+		op := v.operations[route.Operation]
+		var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			v.serve(next, w, r, route, pathParams, op.maxBody)
+		})
+		if op.roles != nil {
+			h = middleware.RequireRole(op.roles...)(h)
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (v *validator) serve(next http.Handler, w http.ResponseWriter, r *http.Request, route *routers.Route, pathParams map[string]string, maxBody int64) {
+	log := logger.FromContext(r.Context()).With(zap.String("operation", route.Operation.OperationID))
+	if r.Body != nil {
+		r.Body = http.MaxBytesReader(w, r.Body, maxBody)
+	}
+	input := &openapi3filter.RequestValidationInput{Request: r, PathParams: pathParams, Route: route, Options: v.options}
+	if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+		fields := fieldErrors(err)
+		log.Warn("Request does not match API spec", zap.String("error", fields.Message()))
+		if isSCIM(r) {
+			writeSCIMError(w, scimRequestError(err, fields.Message()))
+			return
+		}
+		writeAPIError(w, requestError(err, fields))
+		return
+	}
+	if v.responses == ResponseValidationOff {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	rec := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+	next.ServeHTTP(rec, r)
+	err := openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.status,
+		Header:                 rec.header,
+		Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+		Options:                v.options,
+	})
+	if err != nil {
+		log.Error("Response does not match API spec", zap.String("error", fieldErrors(err).Message()), zap.Int("status", rec.status))
+		if v.responses == ResponseValidationStrict {
+			writeInternalError(w, r, "response does not match API spec")
+			return
+		}
+	}
+	rec.flush(w)
+}
+
+// fieldErrors раскладывает ошибку проверки по полям: путь в теле ("members[1].user_id"),
+// имя параметра или "body"; без дампа схемы и значения
+func fieldErrors(err error) *models.ValidationError {
+	v := &models.ValidationError{}
+	collectFieldErrors(v, err, "body")
+	// Порядок ошибок kin-openapi зависит от обхода map - сортируем, убирая повторы
+	sort.SliceStable(v.Fields, func(i, j int) bool { return v.Fields[i].Field < v.Fields[j].Field })
+	v.Fields = slices.Compact(v.Fields)
+	return v
+}
+
+func collectFieldErrors(v *models.ValidationError, err error, where string) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, item := range e {
+			collectFieldErrors(v, item, where)
+		}
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			where = e.Parameter.Name
+		}
+		if e.Err == nil {
+			v.Add(where, e.Reason)
+			return
+		}
+		collectFieldErrors(v, e.Err, where)
+	case *openapi3.SchemaError:
+		ptr := e.JSONPointer()
+		reason := e.Reason
+		if e.Origin != nil {
+			reason = e.Origin.Error()
+		}
+		// Неизвестное поле kin-openapi относит к объекту - указываем само поле
+		if name, ok := unknownProperty(e); ok {
+			ptr = append(ptr, name)
+			reason = "unknown field"
+		}
+		field := where
+		if len(ptr) > 0 {
+			field = jsonPath(where, ptr)
+		}
+		v.Add(field, reason)
+	default:
+		var sizeErr *http.MaxBytesError
+		if errors.As(err, &sizeErr) {
+			v.Add("body", fmt.Sprintf("must not exceed %d bytes", sizeErr.Limit))
+			return
+		}
+		v.Add(where, err.Error())
+	}
+}
+
+func unknownProperty(e *openapi3.SchemaError) (string, bool) {
+	quoted, ok := strings.CutPrefix(e.Reason, "property ")
+	if !ok || e.SchemaField != "properties" {
+		return "", false
+	}
+	quoted, ok = strings.CutSuffix(quoted, " is unsupported")
+	if !ok {
+		return "", false
+	}
+	name, err := strconv.Unquote(quoted)
+	return name, err == nil
+}
+
+// jsonPath - путь в формате API: ["members", "1", "user_id"] -> members[1].user_id
+func jsonPath(where string, ptr []string) string {
+	var b strings.Builder
+	if where != "body" {
+		b.WriteString(where)
+	}
+	for _, part := range ptr {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// responseRecorder держит ответ, пока он не проверен
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) Header() http.Header { return rec.header }
+
+func (rec *responseRecorder) WriteHeader(status int) { rec.status = status }
+
+func (rec *responseRecorder) Write(p []byte) (int, error) { return rec.body.Write(p) }
+
+func (rec *responseRecorder) flush(w http.ResponseWriter) {
+	for key, values := range rec.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(rec.status)
+	w.Write(rec.body.Bytes())
+}
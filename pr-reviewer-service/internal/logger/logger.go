@@ -2,14 +2,42 @@ package logger
 
 import (
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
-var Logger *zap.Logger
+// Logger - до Init ничего не пишет (тесты, утилиты без логирования)
+var Logger = zap.NewNop()
 
-func Init() {
+// level - текущий уровень логирования; меняется на лету через SetLevel
+var level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+
+// Init создаёт production-логгер с уровнем из LOG_LEVEL (debug, info, warn, error).
+// Неизвестный уровень - info
+func Init(logLevel string) {
+	if err := SetLevel(logLevel); err != nil {
+		level.SetLevel(zapcore.InfoLevel)
+	}
+
+	cfg := zap.NewProductionConfig()
+	cfg.Level = level
 	var err error
-	Logger, err = zap.NewProduction() 
+	Logger, err = cfg.Build()
 	if err != nil {
 		panic(err)
 	}
 }
+
+// SetLevel меняет уровень логирования без перезапуска
+func SetLevel(name string) error {
+	l, err := zapcore.ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	level.SetLevel(l)
+	return nil
+}
+
+// Level - текущий уровень логирования
+func Level() string {
+	return level.Level().String()
+}
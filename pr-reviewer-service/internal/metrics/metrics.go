@@ -0,0 +1,145 @@
+// Package metrics - метрики Prometheus сервиса (эндпоинт /metrics)
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/common/expfmt"
+)
+
+const namespace = "pr_reviewer"
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route pattern, method and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route pattern, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// TxRollbacks - откаты транзакций; method - "Repository.Method"
+	TxRollbacks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_tx_rollbacks_total",
+		Help:      "Database transaction rollbacks by repository method.",
+	}, []string{"method"})
+
+	PRsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_created_total",
+		Help:      "Pull requests created.",
+	})
+
+	PRsMerged = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_merged_total",
+		Help:      "Pull requests merged.",
+	})
+
+	PRsReassigned = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reviewers_reassigned_total",
+		Help:      "Reviewer reassignments.",
+	})
+
+	// NoCandidate - не нашлось ревьювера; operation - reassign или add_reviewer
+	NoCandidate = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_total",
+		Help:      "Reviewer selections that failed with NO_CANDIDATE.",
+	}, []string{"operation"})
+)
+
+// Register регистрирует метрики сервиса, статистику пула sql.DB и нагрузку ревьюверов.
+// openLoad вызывается при каждом scrape
+func Register(db *sql.DB, openLoad func(context.Context) (map[int]int, error)) {
+	prometheus.MustRegister(
+		httpRequests, httpDuration, TxRollbacks,
+		PRsCreated, PRsMerged, PRsReassigned, NoCandidate,
+		collectors.NewDBStatsCollector(db, "pr_reviewer"),
+		&loadCollector{openLoad: openLoad},
+	)
+}
+
+// Text - метрики в текстовом формате Prometheus (эндпоинт /metrics)
+func Text() (string, error) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, mf := range families {
+		if _, err := expfmt.MetricFamilyToText(&b, mf); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// Middleware считает запросы и их длительность по шаблону маршрута chi
+// ("/team/get", а не URL с параметрами), чтобы не плодить метки
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		labels := []string{r.Method, route, strconv.Itoa(status)}
+		httpRequests.WithLabelValues(labels...).Inc()
+		httpDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	})
+}
+
+var openLoadDesc = prometheus.NewDesc(
+	namespace+"_open_review_load",
+	"Open pull requests assigned to a reviewer.",
+	[]string{"reviewer_id"}, nil,
+)
+
+// Время на запрос нагрузки при scrape: Collect не получает контекст запроса
+const loadQueryTimeout = 10 * time.Second
+
+// loadCollector читает нагрузку из БД в момент scrape, а не хранит копию в памяти
+type loadCollector struct {
+	openLoad func(context.Context) (map[int]int, error)
+}
+
+func (c *loadCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- openLoadDesc
+}
+
+func (c *loadCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), loadQueryTimeout)
+	defer cancel()
+	load, err := c.openLoad(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(openLoadDesc, err)
+		return
+	}
+	for reviewerID, cnt := range load {
+		ch <- prometheus.MustNewConstMetric(openLoadDesc, prometheus.GaugeValue, float64(cnt), "u"+strconv.Itoa(reviewerID))
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type principalKey struct{}
+
+// Authenticator проверяет предъявленный ключ и возвращает его владельца
+type Authenticator interface {
+	Authenticate(ctx context.Context, raw string) (*models.Principal, error)
+}
+
+// Authenticate читает ключ или токен из X-API-Key или Authorization: Bearer и кладёт
+// владельца в контекст запроса. Аутентификаторы пробуются по очереди: "not found"
+// значит "не мой формат/ключ". Запрос без учётных данных проходит дальше - решение
+// принимает RequireRole; неверный, просроченный или отозванный ключ сразу получает 401
+func Authenticate(auths ...Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw := credentials(r)
+			if raw == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := Resolve(r.Context(), auths, raw)
+			if errors.Is(err, models.ErrAuthUnavailable) {
+				logger.FromContext(r.Context()).Error("Failed to check credentials", zap.Error(err), zap.String("path", r.URL.Path))
+				writeError(w, http.StatusInternalServerError, "INTERNAL", "failed to check credentials")
+				return
+			}
+			if err != nil {
+				logger.FromContext(r.Context()).Warn("Failed to authenticate request", zap.Error(err), zap.String("path", r.URL.Path))
+				writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// Resolve - владелец ключа или токена raw по первому аутентификатору, признавшему его своим.
+// Ошибка означает 401 (в gRPC - Unauthenticated), кроме models.ErrAuthUnavailable - это 500
+func Resolve(ctx context.Context, auths []Authenticator, raw string) (*models.Principal, error) {
+	for _, auth := range auths {
+		principal, err := auth.Authenticate(ctx, raw)
+		if err == nil {
+			return principal, nil
+		}
+		if err.Error() != "not found" {
+			return nil, err
+		}
+	}
+	return nil, errors.New("invalid or revoked API key")
+}
+
+// RequireRole пропускает только запросы с одной из ролей; admin разрешён всегда
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := PrincipalFrom(r.Context())
+			if principal == nil {
+				writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "API key required")
+				return
+			}
+			if HasRole(principal, roles...) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			logger.FromContext(r.Context()).Warn("Access denied", zap.String("principal", principal.Name), zap.String("role", principal.Role), zap.String("path", r.URL.Path))
+			writeError(w, http.StatusForbidden, "FORBIDDEN", "role "+principal.Role+" is not allowed to call this endpoint")
+		})
+	}
+}
+
+// HasRole - есть ли у владельца одна из ролей; admin разрешён всегда
+func HasRole(principal *models.Principal, roles ...string) bool {
+	if principal.Role == models.RoleAdmin {
+		return true
+	}
+	for _, role := range roles {
+		if principal.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Наборы ролей для операций: в HTTP - через Require*, в gRPC - по имени метода
+var (
+	AdminRoles  = []string{models.RoleAdmin}
+	BotRoles    = []string{models.RoleBot}
+	ReaderRoles = []string{models.RoleReader, models.RoleBot, models.RoleReviewer}
+	// ReviewerRoles - бот или пользователь; ограничение "только свои ревью" проверяет handler
+	ReviewerRoles = []string{models.RoleBot, models.RoleReviewer}
+)
+
+var (
+	RequireAdmin    = RequireRole(AdminRoles...)
+	RequireBot      = RequireRole(BotRoles...)
+	RequireReader   = RequireRole(ReaderRoles...)
+	RequireReviewer = RequireRole(ReviewerRoles...)
+)
+
+func WithPrincipal(ctx context.Context, principal *models.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom - владелец ключа текущего запроса или nil
+func PrincipalFrom(ctx context.Context) *models.Principal {
+	p, _ := ctx.Value(principalKey{}).(*models.Principal)
+	return p
+}
+
+func credentials(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+	if h := r.Header.Get("Authorization"); len(h) > 7 && strings.EqualFold(h[:7], "Bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	return ""
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(models.ErrorResponse{
+		Error: models.ErrorDetail{Code: code, Message: message},
+	})
+}
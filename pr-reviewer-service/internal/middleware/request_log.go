@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"pr-reviewer-service/internal/logger"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader - заголовок для сквозного ID запроса
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLen - более длинный (или с непечатными символами) входящий ID заменяется своим
+const maxRequestIDLen = 128
+
+// RequestLogger берёт X-Request-ID из запроса или генерирует новый, возвращает его в ответе,
+// кладёт в контекст логгер с request_id и один раз пишет итог запроса:
+// метод, шаблон маршрута, статус и длительность
+func RequestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := RequestID(r.Header.Get(RequestIDHeader))
+		w.Header().Set(RequestIDHeader, requestID)
+
+		reqLogger := logger.Logger.With(zap.String("request_id", requestID))
+		ctx := logger.WithContext(r.Context(), reqLogger)
+
+		ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		fields := []zap.Field{
+			zap.String("method", r.Method),
+			zap.String("route", route),
+			zap.Int("status", status),
+			zap.Duration("duration", time.Since(start)),
+			zap.Int("bytes", ww.BytesWritten()),
+		}
+
+		log := logger.FromContext(ctx)
+		switch {
+		case status >= http.StatusInternalServerError:
+			log.Error("HTTP request", fields...)
+		case status >= http.StatusBadRequest:
+			log.Warn("HTTP request", fields...)
+		default:
+			log.Info("HTTP request", fields...)
+		}
+	})
+}
+
+// RequestID - входящий ID запроса, если он годится, иначе новый
+func RequestID(incoming string) string {
+	if validRequestID(incoming) {
+		return incoming
+	}
+	return newRequestID()
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
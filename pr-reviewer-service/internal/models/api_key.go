@@ -0,0 +1,36 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAuthUnavailable - учётные данные не удалось проверить (например, недоступна БД).
+// Это не 401: клиент ни в чём не виноват
+var ErrAuthUnavailable = errors.New("authentication unavailable")
+
+// Роли доступа к API
+const (
+	RoleAdmin  = "admin"  // команды, пользователи, ключи
+	RoleBot    = "bot"    // создание, merge и переназначение PR
+	RoleReader = "reader" // только GET-эндпоинты
+	// RoleReviewer - пользователь по JWT: чтение и переназначение своих ревью
+	RoleReviewer = "reviewer"
+)
+
+type APIKey struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Role      string     `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Principal - тот, кто выполняет запрос (определяется middleware аутентификации)
+type Principal struct {
+	Name  string
+	Role  string
+	KeyID int // 0 для bootstrap-ключа из конфигурации
+	// UserID - пользователь из claims JWT; 0 для API-ключей
+	UserID int
+}
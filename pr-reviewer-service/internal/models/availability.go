@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Unavailability - период, когда пользователь не может принимать ревью.
+// is_active при этом не меняется: он остаётся только для постоянной деактивации
+type Unavailability struct {
+	ID       int       `json:"id"`
+	UserID   int       `json:"user_id"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   string    `json:"reason,omitempty"`
+}
@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+// BulkData - выгрузка/загрузка данных сервиса. Сущности плоские, чтобы каждая
+// ложилась в отдельный CSV: teams, users, members, prs, assignments
+type BulkData struct {
+	Teams        []BulkTeam       `json:"teams"`
+	Users        []BulkUser       `json:"users"`
+	Members      []BulkMember     `json:"members"`
+	PullRequests []BulkPR         `json:"pull_requests"`
+	Assignments  []BulkAssignment `json:"assignments"`
+}
+
+type BulkTeam struct {
+	TeamName string `json:"team_name"`
+}
+
+type BulkUser struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type BulkMember struct {
+	TeamName string `json:"team_name"`
+	UserID   int    `json:"user_id"`
+}
+
+type BulkPR struct {
+	ID        int        `json:"pull_request_id"`
+	Title     string     `json:"title"`
+	AuthorID  int        `json:"author_id"`
+	TeamName  string     `json:"team_name"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at,omitempty"`
+}
+
+type BulkAssignment struct {
+	PRID       int       `json:"pull_request_id"`
+	ReviewerID int       `json:"reviewer_id"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+// Действия в отчёте об импорте
+const (
+	ImportCreate = "CREATE"
+	ImportUpdate = "UPDATE"
+)
+
+// ImportResult - что изменил (или изменил бы в dry-run) импорт. Неизменённые записи
+// в Changes не попадают, только в счётчики
+type ImportResult struct {
+	DryRun  bool                     `json:"dry_run"`
+	Summary map[string]*ImportCounts `json:"summary"`
+	Changes []ImportChange           `json:"changes"`
+}
+
+type ImportCounts struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+type ImportChange struct {
+	Entity string      `json:"entity"`
+	Key    string      `json:"key"`
+	Action string      `json:"action"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after"`
+}
@@ -0,0 +1,33 @@
+package models
+
+// OwnershipRule - правило владения путями команды в стиле CODEOWNERS.
+// Для файла действует последнее совпавшее правило (по position)
+type OwnershipRule struct {
+	ID         int      `json:"id"`
+	TeamName   string   `json:"team_name"`
+	Position   int      `json:"position"`
+	Pattern    string   `json:"pattern"`
+	UserIDs    []int    `json:"user_ids"`    // владельцы-пользователи
+	OwnerTeams []string `json:"owner_teams"` // владельцы-подгруппы (все участники команды)
+}
+
+// HandleMapping - соответствие владельца из CODEOWNERS пользователю или команде
+type HandleMapping struct {
+	Handle   string `json:"handle"`              // @user, @org/team или email
+	UserID   int    `json:"user_id,omitempty"`   // задаётся либо пользователь,
+	TeamName string `json:"team_name,omitempty"` // либо команда
+}
+
+// CodeownersImportReport - результат импорта файла CODEOWNERS
+type CodeownersImportReport struct {
+	TeamName       string            `json:"team_name"`
+	ImportedRules  int               `json:"imported_rules"`
+	UnknownHandles []string          `json:"unknown_handles"`
+	Problems       []ImportLineError `json:"problems"`
+}
+
+// ImportLineError - строка файла, которую не удалось импортировать
+type ImportLineError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
@@ -1,10 +1,49 @@
 package models
 
+import "strings"
+
 type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details []FieldError `json:"details,omitempty"` // для BAD_REQUEST - проблемы по полям
 }
 
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
+
+// FieldError - проблема с одним полем запроса. Field - путь в формате API
+// ("members[1].user_id"), "body" - тело целиком
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError - некорректные входные данные. Error() начинается с "BAD_REQUEST: ",
+// поэтому код, разбирающий ошибки сервисов по префиксу, работает без изменений
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Add(field, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+// Err - nil, если проблем не найдено
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Message - все проблемы одной строкой, для поля message ответа
+func (e *ValidationError) Message() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Field+": "+f.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Error() string { return "BAD_REQUEST: " + e.Message() }
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// Notification - событие по PR для чата команды PR (Event - одно из notify.Event*)
+type Notification struct {
+	Event string
+	PRID  int
+	// Recipients - к кому обращено сообщение; отказавшиеся от уведомлений не упоминаются
+	Recipients    []int
+	OldReviewerID int    // reassigned
+	Policy        string // sla_breach
+	Hours         int    // sla_breach
+}
+
+// NotificationPR - PR с вебхуком его команды (пусто - у команды нет вебхука)
+type NotificationPR struct {
+	ID         int
+	Title      string
+	AuthorID   int
+	CreatedAt  time.Time
+	TeamName   string
+	WebhookURL string
+}
+
+// NotificationUser - имя пользователя и его согласие на уведомления
+type NotificationUser struct {
+	Name    string
+	Enabled bool
+}
+
+type TeamWebhook struct {
+	TeamName string `json:"team_name"`
+	URL      string `json:"url"`
+}
+
+// Каналы дайджеста: отметка об отправке за день у каждого своя
+const (
+	DigestChannelChat  = "chat"
+	DigestChannelEmail = "email"
+)
+
+// ReviewerDigest - OPEN-ревью ревьювера для email-дайджеста. Письмо получают только
+// активные ревьюверы с email, не отказавшиеся от уведомлений
+type ReviewerDigest struct {
+	UserID   int
+	Name     string
+	Email    string // пусто - адрес не задан
+	IsActive bool
+	Reviews  []PendingReview
+}
+
+type PendingReview struct {
+	PRID       int
+	Title      string
+	AuthorName string
+	AssignedAt time.Time
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Действия и причины в истории назначений
+const (
+	AssignmentAssigned   = "ASSIGNED"
+	AssignmentUnassigned = "UNASSIGNED"
+
+	AssignReasonCreated    = "CREATED"    // назначен при создании PR
+	AssignReasonReassigned = "REASSIGNED" // замена через /pullRequest/reassign или SLA
+	AssignReasonAdded      = "ADDED"      // дополнительный ревьювер (SLA ADD_REVIEWER)
+)
+
+// ReviewerInfo - назначенный ревьювер с именем
+type ReviewerInfo struct {
+	UserID     int       `json:"-"`
+	Username   string    `json:"username"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+func (r ReviewerInfo) MarshalJSON() ([]byte, error) {
+	type Alias ReviewerInfo
+	return json.Marshal(&struct {
+		UserID string `json:"user_id"`
+		Alias
+	}{
+		UserID: fmt.Sprintf("u%d", r.UserID),
+		Alias:  (Alias)(r),
+	})
+}
+
+// AssignmentEvent - запись истории назначений
+type AssignmentEvent struct {
+	ReviewerID int       `json:"-"`
+	Username   string    `json:"username"`
+	Action     string    `json:"action"`
+	Reason     string    `json:"reason"`
+	At         time.Time `json:"at"`
+}
+
+func (e AssignmentEvent) MarshalJSON() ([]byte, error) {
+	type Alias AssignmentEvent
+	return json.Marshal(&struct {
+		ReviewerID string `json:"reviewer_id"`
+		Alias
+	}{
+		ReviewerID: fmt.Sprintf("u%d", e.ReviewerID),
+		Alias:      (Alias)(e),
+	})
+}
+
+// PRDetails - PR со всем, что нужно клиенту без повторных запросов
+type PRDetails struct {
+	PullRequest
+	TeamName  string
+	Reviewers []ReviewerInfo
+	History   []AssignmentEvent
+}
+
+// MarshalJSON - поля PullRequest в том же формате, что и везде, плюс детали
+func (d PRDetails) MarshalJSON() ([]byte, error) {
+	base, err := json.Marshal(d.PullRequest)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(base, &fields); err != nil {
+		return nil, err
+	}
+
+	extra := map[string]interface{}{
+		"team_name": d.TeamName,
+		"reviewers": d.Reviewers,
+		"history":   d.History,
+	}
+	for key, value := range extra {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = raw
+	}
+	return json.Marshal(fields)
+}
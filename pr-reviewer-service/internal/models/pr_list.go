@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Поля сортировки списка PR
+const (
+	PRSortCreatedAt = "created_at"
+	PRSortMergedAt  = "merged_at"
+	PRSortTitle     = "title"
+	PRSortStatus    = "status"
+	PRSortID        = "id"
+)
+
+// PRListFilter - фильтры, сортировка и страница для GET /pullRequest/list.
+// Нулевые значения фильтров означают "не фильтровать"
+type PRListFilter struct {
+	Status     string
+	TeamName   string
+	AuthorID   int
+	ReviewerID int
+	// Диапазоны дат: From включительно, To не включительно
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	// Query - подстрока в названии (без учёта регистра)
+	Query string
+
+	Sort   string
+	Desc   bool
+	Limit  int
+	Cursor string
+}
+
+// PRListPage - страница списка; NextCursor пуст на последней странице
+type PRListPage struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
@@ -14,6 +14,14 @@ type PullRequest struct {
 	AssignedReviewers []int      `json:"assigned_reviewers"`
 	CreatedAt         time.Time  `json:"created_at,omitempty"`
 	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	Understaffed      bool       `json:"understaffed,omitempty"` // ревьюверов меньше, чем нужно
+}
+
+// ExternalPR - MR во внешней системе (GitLab), из которого создан PR сервиса
+type ExternalPR struct {
+	Provider  string
+	ProjectID int64
+	Number    int // iid MR в проекте
 }
 
 // Кастомный MarshalJSON: преобразует ID-шники в формат API
@@ -1,5 +1,14 @@
 package models
 
+import "time"
+
 type Reviewer struct {
 	UserID int `json:"user_id"`
 }
+
+// ReviewAssignment - назначение ревьювера на PR
+type ReviewAssignment struct {
+	PRID       int
+	ReviewerID int
+	AssignedAt time.Time
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkSchedule - часовой пояс и рабочие часы пользователя
+type WorkSchedule struct {
+	UserID    int    `json:"user_id"`
+	TimeZone  string `json:"time_zone"`  // IANA, например Europe/Moscow
+	WorkStart string `json:"work_start"` // HH:MM, локальное время
+	WorkEnd   string `json:"work_end"`   // HH:MM, локальное время
+	WorkDays  []int  `json:"work_days"`  // ISO: 1 - понедельник ... 7 - воскресенье
+}
+
+// DefaultWorkSchedule - расписание по умолчанию (совпадает с DEFAULT в БД)
+func DefaultWorkSchedule() WorkSchedule {
+	return WorkSchedule{TimeZone: "UTC", WorkStart: "09:00", WorkEnd: "18:00", WorkDays: []int{1, 2, 3, 4, 5}}
+}
+
+// Validate проверяет часовой пояс, формат времени и дни недели
+func (s WorkSchedule) Validate() error {
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("unknown time_zone %q", s.TimeZone)
+	}
+	start, err := parseClock(s.WorkStart)
+	if err != nil {
+		return fmt.Errorf("invalid work_start: %w", err)
+	}
+	end, err := parseClock(s.WorkEnd)
+	if err != nil {
+		return fmt.Errorf("invalid work_end: %w", err)
+	}
+	if end <= start {
+		return fmt.Errorf("work_end must be after work_start")
+	}
+	if len(s.WorkDays) == 0 {
+		return fmt.Errorf("work_days must not be empty")
+	}
+	for _, d := range s.WorkDays {
+		if d < 1 || d > 7 {
+			return fmt.Errorf("work_days must be ISO weekdays 1..7")
+		}
+	}
+	return nil
+}
+
+// IsWorking - попадает ли момент t в рабочие часы
+func (s WorkSchedule) IsWorking(t time.Time) bool {
+	return s.UntilWorking(t) == 0
+}
+
+// UntilWorking - сколько осталось до начала ближайшего рабочего окна (0, если оно уже идёт).
+// Если рабочих окон нет (некорректное расписание), возвращает неделю
+func (s WorkSchedule) UntilWorking(t time.Time) time.Duration {
+	local := t.In(s.location())
+	for day := 0; day <= 7; day++ {
+		from, to, ok := s.window(local, day)
+		if !ok || !to.After(local) {
+			continue
+		}
+		if !from.After(local) {
+			return 0
+		}
+		return from.Sub(local)
+	}
+	return 7 * 24 * time.Hour
+}
+
+// WorkingDuration - сколько рабочего времени прошло между from и to
+func (s WorkSchedule) WorkingDuration(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	loc := s.location()
+	localFrom := from.In(loc)
+	localTo := to.In(loc)
+
+	var total time.Duration
+	for day := 0; ; day++ {
+		start, end, ok := s.window(localFrom, day)
+		if ok {
+			if start.After(localTo) {
+				break
+			}
+			if start.Before(localFrom) {
+				start = localFrom
+			}
+			if end.After(localTo) {
+				end = localTo
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		} else if dayStart(localFrom, day).After(localTo) {
+			break
+		}
+	}
+	return total
+}
+
+// window - рабочее окно в день base+offset (в часовом поясе пользователя)
+func (s WorkSchedule) window(base time.Time, offset int) (time.Time, time.Time, bool) {
+	day := dayStart(base, offset)
+	if !s.isWorkDay(day.Weekday()) {
+		return time.Time{}, time.Time{}, false
+	}
+	start, err1 := parseClock(s.WorkStart)
+	end, err2 := parseClock(s.WorkEnd)
+	if err1 != nil || err2 != nil || end <= start {
+		return time.Time{}, time.Time{}, false
+	}
+	return day.Add(start), day.Add(end), true
+}
+
+func (s WorkSchedule) isWorkDay(wd time.Weekday) bool {
+	iso := int(wd)
+	if iso == 0 {
+		iso = 7
+	}
+	for _, d := range s.WorkDays {
+		if d == iso {
+			return true
+		}
+	}
+	return false
+}
+
+func (s WorkSchedule) location() *time.Location {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func dayStart(t time.Time, offset int) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+offset, 0, 0, 0, 0, t.Location())
+}
+
+// parseClock разбирает HH:MM или HH:MM:SS в смещение от полуночи
+func parseClock(v string) (time.Duration, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("expected HH:MM, got %q", v)
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Схемы SCIM 2.0 (RFC 7643, RFC 7644)
+const (
+	ScimUserSchema          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimGroupSchema         = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ScimListResponseSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	ScimErrorSchema         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ScimPatchOpSchema       = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ScimServiceConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// ScimRef - ссылка на пользователя или группу (members, groups)
+type ScimRef struct {
+	ID      int
+	Display string
+}
+
+// ScimUser - пользователь сервиса в терминах SCIM: userName - users.name, active - users.is_active.
+// Группы (команды) только для чтения, членство меняется через /Groups
+type ScimUser struct {
+	ID         int
+	ExternalID string
+	UserName   string
+	Active     bool
+	Groups     []ScimRef
+}
+
+// ScimGroup - команда: displayName - teams.name, members - team_members
+type ScimGroup struct {
+	ID          int
+	ExternalID  string
+	DisplayName string
+	Members     []ScimRef
+}
+
+// ScimFilter - поддерживается только `<attr> eq "<value>"`, этого хватает IdP для поиска по userName/displayName
+type ScimFilter struct {
+	Attr  string
+	Value string
+}
+
+// ScimPatchOp - операция PATCH; value разбирает сервис: его тип зависит от path
+type ScimPatchOp struct {
+	Op    string
+	Path  string
+	Value json.RawMessage
+}
+
+// ParseScimBool - некоторые IdP присылают boolean строкой ("False")
+func ParseScimBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		if b, err := strconv.ParseBool(str); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("expected a boolean, got %s", raw)
+}
+
+// ParseScimRefs - список [{"value": "<id>"}] (members)
+func ParseScimRefs(raw json.RawMessage) ([]ScimRef, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var items []struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf(`expected a list of {"value": "<id>"}`)
+	}
+	refs := make([]ScimRef, 0, len(items))
+	for _, item := range items {
+		id, err := ParseScimID(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ScimRef{ID: id})
+	}
+	return refs, nil
+}
+
+// ParseScimID - id ресурса: числовой ID пользователя или команды
+func ParseScimID(value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id %q", value)
+	}
+	return id, nil
+}
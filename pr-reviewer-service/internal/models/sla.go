@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Политики реакции на просроченное ревью
+const (
+	SLAPolicyEscalate    = "ESCALATE"
+	SLAPolicyAddReviewer = "ADD_REVIEWER"
+	SLAPolicyReassign    = "REASSIGN"
+)
+
+type TeamSLA struct {
+	TeamName           string `json:"team_name"`
+	FirstResponseHours int    `json:"first_response_hours"`
+	Policy             string `json:"policy"` // ESCALATE|ADD_REVIEWER|REASSIGN
+}
+
+// OverdueReview - назначение ревьювера на OPEN PR, вышедшее за SLA команды
+type OverdueReview struct {
+	PRID               int
+	ReviewerID         int
+	TeamID             int
+	AssignedAt         time.Time
+	FirstResponseHours int
+	Policy             string
+	Schedule           WorkSchedule // расписание ревьювера: SLA считается только в его рабочих часах
+}
+
+// IsOverdue - истёк ли срок первого ответа, если считать только рабочие часы ревьювера
+func (o OverdueReview) IsOverdue(now time.Time) bool {
+	return o.Schedule.WorkingDuration(o.AssignedAt, now) >= time.Duration(o.FirstResponseHours)*time.Hour
+}
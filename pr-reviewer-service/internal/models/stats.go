@@ -0,0 +1,20 @@
+package models
+
+// ReviewStats - сводка по сервису для prctl stats
+type ReviewStats struct {
+	Teams           int            `json:"teams"`
+	Users           int            `json:"users"`
+	ActiveUsers     int            `json:"active_users"`
+	OpenPRs         int            `json:"open_prs"`
+	MergedPRs       int            `json:"merged_prs"`
+	UnderstaffedPRs int            `json:"understaffed_prs"`
+	AvgMergeHours   float64        `json:"avg_merge_hours"`
+	ReviewerLoad    []ReviewerLoad `json:"reviewer_load"`
+}
+
+// ReviewerLoad - OPEN PR на ревьювере
+type ReviewerLoad struct {
+	UserID      int    `json:"user_id"`
+	Username    string `json:"username"`
+	OpenReviews int    `json:"open_reviews"`
+}
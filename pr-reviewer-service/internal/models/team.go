@@ -10,3 +10,10 @@ type Team struct {
 	TeamName string       `json:"team_name"`
 	Members  []TeamMember `json:"members"`
 }
+
+// TeamSummary - команда в списке: без состава, только численность
+type TeamSummary struct {
+	TeamName      string `json:"team_name"`
+	Members       int    `json:"members"`
+	ActiveMembers int    `json:"active_members"`
+}
@@ -0,0 +1,146 @@
+// Package notify - тексты уведомлений (text/template) и их отправка во входящий
+// вебхук Slack или Mattermost и по email (SMTP)
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// События чата; у каждого свой шаблон <событие>.tmpl
+const (
+	EventAssigned   = "assigned"
+	EventReassigned = "reassigned"
+	EventSLABreach  = "sla_breach"
+	EventMerged     = "merged"
+	EventDigest     = "digest"
+)
+
+// Шаблоны письма с дайджестом: тема и текст
+const (
+	TemplateEmailDigestSubject = "email_digest_subject"
+	TemplateEmailDigest        = "email_digest"
+)
+
+var templateNames = []string{EventAssigned, EventReassigned, EventSLABreach, EventMerged, EventDigest,
+	TemplateEmailDigestSubject, TemplateEmailDigest}
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Person - пользователь в тексте; упоминается через @, только если не отказался от уведомлений
+type Person struct {
+	Name   string
+	Notify bool
+}
+
+// PR - PR в тексте уведомления
+type PR struct {
+	ID     string // pr-N
+	Title  string
+	Author string
+	Age    string // сколько PR открыт (для дайджеста)
+}
+
+// DigestEntry - открытые ревью одного ревьювера
+type DigestEntry struct {
+	Reviewer Person
+	PRs      []PR
+}
+
+// Message - данные шаблона. Заполнены только поля, относящиеся к событию
+type Message struct {
+	Event       string
+	Team        string
+	PR          PR
+	Recipients  []Person
+	OldReviewer string        // reassigned
+	Policy      string        // sla_breach
+	Hours       int           // sla_breach: срок первого ответа
+	Reviews     []DigestEntry // digest
+	Reviewer    string        // email_digest: получатель
+	PRs         []PR          // email_digest: его открытые ревью
+}
+
+var funcs = template.FuncMap{
+	"mention": mention,
+	"mentions": func(people []Person) string {
+		names := make([]string, len(people))
+		for i, p := range people {
+			names[i] = mention(p)
+		}
+		return strings.Join(names, ", ")
+	},
+}
+
+func mention(p Person) string {
+	if p.Notify {
+		return "@" + p.Name
+	}
+	return p.Name
+}
+
+// Templates - шаблоны по имени
+type Templates struct {
+	byName map[string]*template.Template
+}
+
+// LoadTemplates - встроенные шаблоны; файлы <имя>.tmpl из dir (если задан) их заменяют
+func LoadTemplates(dir string) (*Templates, error) {
+	t := &Templates{byName: make(map[string]*template.Template, len(templateNames))}
+	for _, base := range templateNames {
+		name := base + ".tmpl"
+		text, err := fs.ReadFile(defaultTemplates, "templates/"+name)
+		if err != nil {
+			return nil, err
+		}
+		if dir != "" {
+			custom, err := os.ReadFile(filepath.Join(dir, name))
+			switch {
+			case err == nil:
+				text = custom
+			case !errors.Is(err, fs.ErrNotExist):
+				return nil, err
+			}
+		}
+
+		tmpl, err := template.New(name).Funcs(funcs).Parse(string(text))
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", name, err)
+		}
+		t.byName[base] = tmpl
+	}
+	return t, nil
+}
+
+// Render - текст по шаблону name (для чата - имя события)
+func (t *Templates) Render(name string, msg *Message) (string, error) {
+	tmpl, ok := t.byName[name]
+	if !ok {
+		return "", fmt.Errorf("unknown notification template %q", name)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, msg); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Age - длительность для дайджеста: минуты, часы или дни
+func Age(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
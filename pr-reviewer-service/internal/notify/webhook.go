@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"pr-reviewer-service/internal/tracing"
+	"strings"
+	"time"
+)
+
+// Sender отправляет текст во входящий вебхук. Формат {"text": ...} понимают
+// и Slack, и Mattermost
+type Sender struct {
+	http *http.Client
+}
+
+func NewSender() *Sender {
+	return &Sender{http: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *Sender) Send(ctx context.Context, url, text string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "Chat webhook POST")
+	defer func() { tracing.End(span, err) }()
+
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("chat webhook: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Create - сохраняет ключ по его хэшу
+func (r *APIKeyRepository) Create(ctx context.Context, name, role, keyHash string) (*models.APIKey, error) {
+	key := &models.APIKey{Name: name, Role: role}
+	err := queryRowSQL(ctx, r.db, `
+		INSERT INTO api_keys(name, key_hash, role) VALUES($1,$2,$3)
+		RETURNING id, created_at`, name, keyHash, role,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to insert API key", zap.Error(err), zap.String("name", name))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Created API key", zap.Int("key_id", key.ID), zap.String("name", name), zap.String("role", role))
+	return key, nil
+}
+
+// FindActiveByHash - неотозванный ключ по хэшу
+func (r *APIKeyRepository) FindActiveByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	var key models.APIKey
+	err := queryRowSQL(ctx, r.db, `
+		SELECT id, name, role, created_at
+		FROM api_keys
+		WHERE key_hash=$1 AND revoked_at IS NULL`, keyHash,
+	).Scan(&key.ID, &key.Name, &key.Role, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("not found")
+		}
+		logger.FromContext(ctx).Error("Failed to look up API key", zap.Error(err))
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]models.APIKey, error) {
+	rows, err := querySQL(ctx, r.db, "SELECT id, name, role, created_at, revoked_at FROM api_keys ORDER BY id")
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query API keys", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []models.APIKey{}
+	for rows.Next() {
+		var key models.APIKey
+		if err := rows.Scan(&key.ID, &key.Name, &key.Role, &key.CreatedAt, &key.RevokedAt); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan API key", zap.Error(err))
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// Revoke - отзывает ключ (повторный отзыв - not found)
+func (r *APIKeyRepository) Revoke(ctx context.Context, id int) error {
+	res, err := execSQL(ctx, r.db, "UPDATE api_keys SET revoked_at = now() WHERE id=$1 AND revoked_at IS NULL", id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to revoke API key", zap.Error(err), zap.Int("key_id", id))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Revoked API key", zap.Int("key_id", id))
+	return nil
+}
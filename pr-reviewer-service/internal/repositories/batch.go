@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Выборки по множеству ключей одним запросом - для dataloader'ов GraphQL.
+// Несуществующие ключи в результат не попадают, порядок не гарантируется
+
+func intArray(ids []int) interface{} {
+	arr := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		arr = append(arr, int64(id))
+	}
+	return pq.Array(arr)
+}
+
+// GetUsers - пользователи с командой (если команд несколько - первая по имени)
+func (r *UserRepository) GetUsers(ctx context.Context, ids []int) ([]models.User, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT DISTINCT ON (u.id) u.id, u.name, COALESCE(t.name, ''), u.is_active
+		FROM users u
+		LEFT JOIN team_members tm ON tm.user_id = u.id
+		LEFT JOIN teams t ON t.id = tm.team_id
+		WHERE u.id = ANY($1)
+		ORDER BY u.id, t.name`, intArray(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get users", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan user", zap.Error(err))
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// GetTeams - команды с составом (по id пользователя)
+func (r *TeamRepository) GetTeams(ctx context.Context, names []string) ([]models.Team, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT t.name, u.id, u.name, u.is_active
+		FROM teams t
+		LEFT JOIN team_members tm ON tm.team_id = t.id
+		LEFT JOIN users u ON u.id = tm.user_id
+		WHERE t.name = ANY($1)
+		ORDER BY t.name, u.id`, pq.Array(names))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get teams", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var name string
+		var id *int
+		var username *string
+		var active *bool
+		if err := rows.Scan(&name, &id, &username, &active); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan team member", zap.Error(err))
+			return nil, err
+		}
+		if n := len(teams); n == 0 || teams[n-1].TeamName != name {
+			teams = append(teams, models.Team{TeamName: name, Members: []models.TeamMember{}})
+		}
+		// Команда без участников - одна строка с NULL вместо пользователя
+		if id != nil {
+			team := &teams[len(teams)-1]
+			team.Members = append(team.Members, models.TeamMember{UserID: *id, Username: *username, IsActive: *active})
+		}
+	}
+	return teams, rows.Err()
+}
+
+// GetPRs - PR с командой, без ревьюверов (их дают ReviewAssignments)
+func (r *PRRepository) GetPRs(ctx context.Context, ids []int) ([]models.PRDetails, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.understaffed, t.name
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		WHERE pr.id = ANY($1)`, intArray(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PRs", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []models.PRDetails
+	for rows.Next() {
+		var d models.PRDetails
+		if err := rows.Scan(&d.ID, &d.Title, &d.AuthorID, &d.Status, &d.CreatedAt, &d.MergedAt, &d.Understaffed, &d.TeamName); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan PR", zap.Error(err))
+			return nil, err
+		}
+		prs = append(prs, d)
+	}
+	return prs, rows.Err()
+}
+
+// ReviewAssignments - ревьюверы PR prIDs, по времени назначения
+func (r *PRRepository) ReviewAssignments(ctx context.Context, prIDs []int) ([]models.ReviewAssignment, error) {
+	return r.reviewAssignments(ctx, "prr.pr_id = ANY($1)", prIDs)
+}
+
+// OpenReviewAssignments - OPEN-ревью ревьюверов reviewerIDs, старые назначения первыми
+func (r *PRRepository) OpenReviewAssignments(ctx context.Context, reviewerIDs []int) ([]models.ReviewAssignment, error) {
+	return r.reviewAssignments(ctx, "prr.reviewer_id = ANY($1) AND pr.status = 'OPEN'", reviewerIDs)
+}
+
+func (r *PRRepository) reviewAssignments(ctx context.Context, cond string, ids []int) ([]models.ReviewAssignment, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT prr.pr_id, prr.reviewer_id, prr.assigned_at
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pr_id
+		WHERE `+cond+`
+		ORDER BY prr.assigned_at, prr.pr_id, prr.reviewer_id`, intArray(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get review assignments", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []models.ReviewAssignment
+	for rows.Next() {
+		var a models.ReviewAssignment
+		if err := rows.Scan(&a.PRID, &a.ReviewerID, &a.AssignedAt); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan review assignment", zap.Error(err))
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
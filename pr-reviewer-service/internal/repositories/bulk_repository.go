@@ -0,0 +1,404 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+type BulkRepository struct {
+	db *sql.DB
+}
+
+func NewBulkRepository(db *sql.DB) *BulkRepository {
+	return &BulkRepository{db: db}
+}
+
+// Export - все данные одним снимком (REPEATABLE READ), чтобы PR и назначения были согласованы
+func (r *BulkRepository) Export(ctx context.Context) (*models.BulkData, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin export transaction", zap.Error(err))
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	data := &models.BulkData{
+		Teams:        []models.BulkTeam{},
+		Users:        []models.BulkUser{},
+		Members:      []models.BulkMember{},
+		PullRequests: []models.BulkPR{},
+		Assignments:  []models.BulkAssignment{},
+	}
+
+	err = scanAll(ctx, tx, "SELECT name FROM teams ORDER BY name", func(rows *sql.Rows) error {
+		var t models.BulkTeam
+		if err := rows.Scan(&t.TeamName); err != nil {
+			return err
+		}
+		data.Teams = append(data.Teams, t)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export teams", zap.Error(err))
+		return nil, err
+	}
+
+	err = scanAll(ctx, tx, "SELECT id, name, is_active FROM users ORDER BY id", func(rows *sql.Rows) error {
+		var u models.BulkUser
+		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive); err != nil {
+			return err
+		}
+		data.Users = append(data.Users, u)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export users", zap.Error(err))
+		return nil, err
+	}
+
+	err = scanAll(ctx, tx, `
+		SELECT t.name, tm.user_id
+		FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		ORDER BY t.name, tm.user_id`, func(rows *sql.Rows) error {
+		var m models.BulkMember
+		if err := rows.Scan(&m.TeamName, &m.UserID); err != nil {
+			return err
+		}
+		data.Members = append(data.Members, m)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export team members", zap.Error(err))
+		return nil, err
+	}
+
+	err = scanAll(ctx, tx, `
+		SELECT pr.id, pr.title, pr.author_id, t.name, pr.status, pr.created_at, pr.merged_at
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		ORDER BY pr.id`, func(rows *sql.Rows) error {
+		var pr models.BulkPR
+		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.TeamName, &pr.Status, &pr.CreatedAt, &pr.MergedAt); err != nil {
+			return err
+		}
+		data.PullRequests = append(data.PullRequests, pr)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export pull requests", zap.Error(err))
+		return nil, err
+	}
+
+	err = scanAll(ctx, tx, `
+		SELECT pr_id, reviewer_id, assigned_at
+		FROM pr_reviewers
+		ORDER BY pr_id, assigned_at, reviewer_id`, func(rows *sql.Rows) error {
+		var a models.BulkAssignment
+		if err := rows.Scan(&a.PRID, &a.ReviewerID, &a.AssignedAt); err != nil {
+			return err
+		}
+		data.Assignments = append(data.Assignments, a)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to export reviewer assignments", zap.Error(err))
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func scanAll(ctx context.Context, q querier, query string, scan func(*sql.Rows) error) error {
+	rows, err := querySQL(ctx, q, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Import - upsert всех сущностей в одной транзакции: либо применяется всё, либо ничего.
+// Команды и членство только добавляются (как в CreateTeam), пользователи и PR обновляются
+// по ID. В dry-run транзакция откатывается, но отчёт тот же, что при реальном импорте
+func (r *BulkRepository) Import(ctx context.Context, data *models.BulkData, dryRun bool) (*models.ImportResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin import transaction", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "BulkRepository.Import")
+		}
+	}()
+
+	res := &models.ImportResult{DryRun: dryRun, Summary: map[string]*models.ImportCounts{}, Changes: []models.ImportChange{}}
+	for _, entity := range []string{"teams", "users", "members", "pull_requests", "assignments"} {
+		res.Summary[entity] = &models.ImportCounts{}
+	}
+
+	if err = importTeams(ctx, tx, data.Teams, res); err != nil {
+		return nil, err
+	}
+	if err = importUsers(ctx, tx, data.Users, res); err != nil {
+		return nil, err
+	}
+	if err = importMembers(ctx, tx, data.Members, res); err != nil {
+		return nil, err
+	}
+	if err = importPRs(ctx, tx, data.PullRequests, res); err != nil {
+		return nil, err
+	}
+	if err = importAssignments(ctx, tx, data.Assignments, res); err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		// Не ошибка, поэтому мимо rollback() и метрики откатов
+		tx.Rollback()
+		return res, nil
+	}
+
+	// Пользователи и PR пришли со своими ID - сдвигаем последовательности, иначе следующий
+	// INSERT без ID упрётся в занятый ключ. setval вне транзакционности, поэтому только не в dry-run
+	for _, table := range []string{"users", "pull_requests"} {
+		if err = syncIDSequence(ctx, tx, table); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit import transaction", zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Imported data", zap.Int("changes", len(res.Changes)))
+	return res, nil
+}
+
+func addChange(res *models.ImportResult, entity, key, action string, before, after interface{}) {
+	switch action {
+	case models.ImportCreate:
+		res.Summary[entity].Created++
+	case models.ImportUpdate:
+		res.Summary[entity].Updated++
+	}
+	res.Changes = append(res.Changes, models.ImportChange{Entity: entity, Key: key, Action: action, Before: before, After: after})
+}
+
+func importTeams(ctx context.Context, tx *sql.Tx, teams []models.BulkTeam, res *models.ImportResult) error {
+	for _, t := range teams {
+		result, err := execSQL(ctx, tx, "INSERT INTO teams(name) VALUES($1) ON CONFLICT(name) DO NOTHING", t.TeamName)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to import team", zap.Error(err), zap.String("team_name", t.TeamName))
+			return err
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			res.Summary["teams"].Unchanged++
+			continue
+		}
+		addChange(res, "teams", t.TeamName, models.ImportCreate, nil, t)
+	}
+	return nil
+}
+
+func importUsers(ctx context.Context, tx *sql.Tx, users []models.BulkUser, res *models.ImportResult) error {
+	for _, u := range users {
+		var before models.BulkUser
+		err := queryRowSQL(ctx, tx, "SELECT id, name, is_active FROM users WHERE id=$1 FOR UPDATE", u.UserID).
+			Scan(&before.UserID, &before.Username, &before.IsActive)
+		key := fmt.Sprintf("u%d", u.UserID)
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			_, err = execSQL(ctx, tx, "INSERT INTO users(id,name,is_active) VALUES($1,$2,$3)", u.UserID, u.Username, u.IsActive)
+			if err != nil {
+				logger.FromContext(ctx).Error("Failed to import user", zap.Error(err), zap.Int("user_id", u.UserID))
+				return err
+			}
+			addChange(res, "users", key, models.ImportCreate, nil, u)
+		case err != nil:
+			logger.FromContext(ctx).Error("Failed to get user", zap.Error(err), zap.Int("user_id", u.UserID))
+			return err
+		case before == u:
+			res.Summary["users"].Unchanged++
+		default:
+			_, err = execSQL(ctx, tx, "UPDATE users SET name=$2, is_active=$3 WHERE id=$1", u.UserID, u.Username, u.IsActive)
+			if err != nil {
+				logger.FromContext(ctx).Error("Failed to update user", zap.Error(err), zap.Int("user_id", u.UserID))
+				return err
+			}
+			addChange(res, "users", key, models.ImportUpdate, before, u)
+		}
+	}
+	return nil
+}
+
+func importMembers(ctx context.Context, tx *sql.Tx, members []models.BulkMember, res *models.ImportResult) error {
+	for _, m := range members {
+		teamID, err := importTeamID(ctx, tx, m.TeamName)
+		if err != nil {
+			return err
+		}
+		if err := importUserExists(ctx, tx, m.UserID); err != nil {
+			return err
+		}
+
+		result, err := execSQL(ctx, tx, `
+			INSERT INTO team_members(team_id,user_id)
+			VALUES($1,$2) ON CONFLICT DO NOTHING`, teamID, m.UserID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to import team member", zap.Error(err), zap.String("team_name", m.TeamName), zap.Int("user_id", m.UserID))
+			return err
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			res.Summary["members"].Unchanged++
+			continue
+		}
+		addChange(res, "members", fmt.Sprintf("%s/u%d", m.TeamName, m.UserID), models.ImportCreate, nil, m)
+	}
+	return nil
+}
+
+func importPRs(ctx context.Context, tx *sql.Tx, prs []models.BulkPR, res *models.ImportResult) error {
+	for _, pr := range prs {
+		teamID, err := importTeamID(ctx, tx, pr.TeamName)
+		if err != nil {
+			return err
+		}
+		if err := importUserExists(ctx, tx, pr.AuthorID); err != nil {
+			return err
+		}
+
+		before := models.BulkPR{ID: pr.ID}
+		err = queryRowSQL(ctx, tx, `
+			SELECT pr.title, pr.author_id, t.name, pr.status, pr.created_at, pr.merged_at
+			FROM pull_requests pr
+			JOIN teams t ON t.id = pr.team_id
+			WHERE pr.id=$1
+			FOR UPDATE OF pr`, pr.ID,
+		).Scan(&before.Title, &before.AuthorID, &before.TeamName, &before.Status, &before.CreatedAt, &before.MergedAt)
+		key := fmt.Sprintf("pr-%d", pr.ID)
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			_, err = execSQL(ctx, tx, `
+				INSERT INTO pull_requests(id, title, author_id, team_id, status, created_at, merged_at)
+				VALUES($1,$2,$3,$4,$5,$6,$7)`,
+				pr.ID, pr.Title, pr.AuthorID, teamID, pr.Status, pr.CreatedAt, pr.MergedAt)
+			if err != nil {
+				logger.FromContext(ctx).Error("Failed to import PR", zap.Error(err), zap.Int("pr_id", pr.ID))
+				return err
+			}
+			addChange(res, "pull_requests", key, models.ImportCreate, nil, pr)
+		case err != nil:
+			logger.FromContext(ctx).Error("Failed to get PR", zap.Error(err), zap.Int("pr_id", pr.ID))
+			return err
+		case samePR(before, pr):
+			res.Summary["pull_requests"].Unchanged++
+		default:
+			_, err = execSQL(ctx, tx, `
+				UPDATE pull_requests
+				SET title=$2, author_id=$3, team_id=$4, status=$5, created_at=$6, merged_at=$7
+				WHERE id=$1`,
+				pr.ID, pr.Title, pr.AuthorID, teamID, pr.Status, pr.CreatedAt, pr.MergedAt)
+			if err != nil {
+				logger.FromContext(ctx).Error("Failed to update PR", zap.Error(err), zap.Int("pr_id", pr.ID))
+				return err
+			}
+			addChange(res, "pull_requests", key, models.ImportUpdate, before, pr)
+		}
+	}
+	return nil
+}
+
+func samePR(a, b models.BulkPR) bool {
+	if a.Title != b.Title || a.AuthorID != b.AuthorID || a.TeamName != b.TeamName || a.Status != b.Status || !a.CreatedAt.Equal(b.CreatedAt) {
+		return false
+	}
+	if a.MergedAt == nil || b.MergedAt == nil {
+		return a.MergedAt == nil && b.MergedAt == nil
+	}
+	return a.MergedAt.Equal(*b.MergedAt)
+}
+
+// importAssignments - назначения только добавляются; новые попадают в историю с причиной IMPORT
+func importAssignments(ctx context.Context, tx *sql.Tx, assignments []models.BulkAssignment, res *models.ImportResult) error {
+	for _, a := range assignments {
+		var authorID int
+		err := queryRowSQL(ctx, tx, "SELECT author_id FROM pull_requests WHERE id=$1", a.PRID).Scan(&authorID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("BAD_REQUEST: assignment: PR pr-%d does not exist", a.PRID)
+		}
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to get PR author", zap.Error(err), zap.Int("pr_id", a.PRID))
+			return err
+		}
+		if authorID == a.ReviewerID {
+			return fmt.Errorf("BAD_REQUEST: assignment: author u%d cannot review own PR pr-%d", a.ReviewerID, a.PRID)
+		}
+		if err := importUserExists(ctx, tx, a.ReviewerID); err != nil {
+			return err
+		}
+
+		result, err := execSQL(ctx, tx, `
+			INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at)
+			VALUES($1,$2,$3) ON CONFLICT DO NOTHING`, a.PRID, a.ReviewerID, a.AssignedAt)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to import assignment", zap.Error(err), zap.Int("pr_id", a.PRID), zap.Int("reviewer_id", a.ReviewerID))
+			return err
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			res.Summary["assignments"].Unchanged++
+			continue
+		}
+
+		_, err = execSQL(ctx, tx, `
+			INSERT INTO pr_assignment_events(pr_id, reviewer_id, action, reason, created_at)
+			VALUES($1,$2,'ASSIGNED','IMPORT',$3)`, a.PRID, a.ReviewerID, a.AssignedAt)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to record imported assignment", zap.Error(err), zap.Int("pr_id", a.PRID), zap.Int("reviewer_id", a.ReviewerID))
+			return err
+		}
+		addChange(res, "assignments", fmt.Sprintf("pr-%d/u%d", a.PRID, a.ReviewerID), models.ImportCreate, nil, a)
+	}
+	return nil
+}
+
+// importTeamID - команда должна быть в БД или выше в этом же импорте
+func importTeamID(ctx context.Context, tx *sql.Tx, name string) (int, error) {
+	var id int
+	err := queryRowSQL(ctx, tx, "SELECT id FROM teams WHERE name=$1", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("BAD_REQUEST: team %q does not exist", name)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get team_id", zap.Error(err), zap.String("team_name", name))
+	}
+	return id, err
+}
+
+func importUserExists(ctx context.Context, tx *sql.Tx, userID int) error {
+	var exists bool
+	err := queryRowSQL(ctx, tx, "SELECT EXISTS(SELECT 1 FROM users WHERE id=$1)", userID).Scan(&exists)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to check user", zap.Error(err), zap.Int("user_id", userID))
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("BAD_REQUEST: user u%d does not exist", userID)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// availableCandidateCond - общий фильтр кандидатов в ревьюверы (алиас users - u):
+// пользователь активен и не отмечен как отсутствующий на момент назначения
+const availableCandidateCond = `u.is_active = true
+		  AND NOT EXISTS (
+			SELECT 1 FROM user_unavailability ua
+			WHERE ua.user_id = u.id AND ua.starts_at <= now() AND ua.ends_at > now()
+		  )`
+
+// reviewersPerPR - сколько ревьюверов должно быть у полностью укомплектованного PR
+const reviewersPerPR = 2
+
+// soonWorkingWindow - если рабочий день кандидата начнётся в пределах этого окна,
+// он считается почти доступным и идёт сразу после тех, кто уже работает
+const soonWorkingWindow = 4 * time.Hour
+
+// Получаем нагрузку и расписание для каждого кандидата
+type candidate struct {
+	id       int
+	load     int
+	schedule models.WorkSchedule
+}
+
+// loadCandidates - доступные члены команды с текущей нагрузкой и свободной ёмкостью, кроме exclude
+func loadCandidates(ctx context.Context, tx *sql.Tx, teamID int, exclude []int) ([]candidate, error) {
+	excludeIDs := make([]int64, 0, len(exclude))
+	for _, id := range exclude {
+		excludeIDs = append(excludeIDs, int64(id))
+	}
+
+	// Без блокировки параллельные CreatePR/Reassign видят одну и ту же нагрузку и оба назначают
+	// одного ревьювера сверх лимита. Блокируем членов команды по возрастанию id (без взаимоблокировок
+	// между командами с общими участниками); NO KEY UPDATE не мешает вставкам со ссылкой на users.
+	// Нагрузка ниже читается отдельным запросом - в READ COMMITTED он видит уже закоммиченные назначения
+	if _, err := execSQL(ctx, tx, `
+		SELECT u.id FROM users u
+		JOIN team_members tm ON tm.user_id = u.id
+		WHERE tm.team_id = $1
+		ORDER BY u.id
+		FOR NO KEY UPDATE OF u
+	`, teamID); err != nil {
+		logger.FromContext(ctx).Error("Failed to lock candidates", zap.Error(err), zap.Int("team_id", teamID))
+		return nil, err
+	}
+
+	// Нагрузка - число OPEN PR на ревьювере. Кандидаты, достигшие лимита
+	// (личного или командного по умолчанию), отбрасываются
+	rows, err := querySQL(ctx, tx, `
+		WITH loads AS (
+			SELECT prr.reviewer_id, COUNT(*) as cnt
+			FROM pr_reviewers prr
+			JOIN pull_requests pr ON pr.id = prr.pr_id
+			WHERE pr.status = 'OPEN'
+			GROUP BY prr.reviewer_id
+		)
+		SELECT u.id, COALESCE(l.cnt,0), u.time_zone,
+		       to_char(u.work_start, 'HH24:MI'), to_char(u.work_end, 'HH24:MI'), u.work_days
+		FROM users u
+		JOIN team_members tm ON tm.user_id = u.id
+		JOIN teams t ON t.id = tm.team_id
+		LEFT JOIN loads l ON l.reviewer_id = u.id
+		WHERE tm.team_id = $1 AND NOT (u.id = ANY($2)) AND `+availableCandidateCond+`
+		  AND (COALESCE(u.max_open_reviews, t.default_max_open_reviews) IS NULL
+		       OR COALESCE(l.cnt,0) < COALESCE(u.max_open_reviews, t.default_max_open_reviews))
+	`, teamID, pq.Array(excludeIDs))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query candidates with load", zap.Error(err), zap.Int("team_id", teamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cands []candidate
+	for rows.Next() {
+		var c candidate
+		var days []int64
+		if err := rows.Scan(&c.id, &c.load, &c.schedule.TimeZone, &c.schedule.WorkStart, &c.schedule.WorkEnd, pq.Array(&days)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan candidate", zap.Error(err), zap.Int("team_id", teamID))
+			return nil, err
+		}
+		c.schedule.UserID = c.id
+		for _, d := range days {
+			c.schedule.WorkDays = append(c.schedule.WorkDays, int(d))
+		}
+		cands = append(cands, c)
+	}
+	return cands, rows.Err()
+}
+
+// rankCandidates упорядочивает кандидатов: сначала те, кто сейчас в рабочих часах,
+// затем те, у кого рабочий день скоро начнётся, затем остальные; внутри группы - по нагрузке.
+// При равной нагрузке порядок случайный, как раньше в SQL (ORDER BY cnt, RANDOM())
+func rankCandidates(cands []candidate, now time.Time) {
+	rand.Shuffle(len(cands), func(i, j int) { cands[i], cands[j] = cands[j], cands[i] })
+
+	bucket := func(c candidate) int {
+		until := c.schedule.UntilWorking(now)
+		switch {
+		case until == 0:
+			return 0
+		case until <= soonWorkingWindow:
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		bi, bj := bucket(cands[i]), bucket(cands[j])
+		if bi != bj {
+			return bi < bj
+		}
+		return cands[i].load < cands[j].load
+	})
+}
+
+// pickReviewers берёт n кандидатов из уже ранжированного списка. Если у изменённых путей
+// есть владельцы, а среди уже назначенных ревьюверов их нет (hasOwner = false), первым
+// берётся лучший по рангу владелец. Если доступных владельцев нет - выбор только по рангу
+func pickReviewers(ctx context.Context, cands []candidate, owners map[int]bool, hasOwner bool, n int) []int {
+	var selected []int
+	taken := make(map[int]bool)
+
+	if len(owners) > 0 && !hasOwner && n > 0 {
+		for _, c := range cands {
+			if owners[c.id] {
+				selected = append(selected, c.id)
+				taken[c.id] = true
+				break
+			}
+		}
+		if len(selected) == 0 {
+			logger.FromContext(ctx).Warn("No available code owners among candidates, falling back to load balancing")
+		}
+	}
+
+	for _, c := range cands {
+		if len(selected) >= n {
+			break
+		}
+		if !taken[c.id] {
+			selected = append(selected, c.id)
+			taken[c.id] = true
+		}
+	}
+	return selected
+}
@@ -0,0 +1,365 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/codeowners"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type CodeOwnersRepository struct {
+	db *sql.DB
+}
+
+func NewCodeOwnersRepository(db *sql.DB) *CodeOwnersRepository {
+	return &CodeOwnersRepository{db: db}
+}
+
+// AddRule - добавляет правило в конец списка правил команды
+func (r *CodeOwnersRepository) AddRule(ctx context.Context, rule *models.OwnershipRule) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx AddRule", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "CodeOwnersRepository.AddRule")
+		}
+	}()
+
+	var teamID int
+	err = queryRowSQL(ctx, tx, "SELECT id FROM teams WHERE name=$1", rule.TeamName).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("not found: team '%s'", rule.TeamName)
+			return err
+		}
+		logger.FromContext(ctx).Error("Failed to get team_id", zap.Error(err), zap.String("team_name", rule.TeamName))
+		return err
+	}
+
+	err = queryRowSQL(ctx, tx, `
+		INSERT INTO code_owner_rules(team_id, position, pattern)
+		SELECT $1, COALESCE(MAX(position), 0) + 1, $2 FROM code_owner_rules WHERE team_id=$1
+		RETURNING id, position`, teamID, rule.Pattern,
+	).Scan(&rule.ID, &rule.Position)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to insert ownership rule", zap.Error(err), zap.String("team_name", rule.TeamName))
+		return err
+	}
+
+	if err = insertRuleOwners(ctx, tx, rule); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit AddRule", zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Info("Added ownership rule",
+		zap.String("team_name", rule.TeamName),
+		zap.Int("rule_id", rule.ID),
+		zap.String("pattern", rule.Pattern),
+	)
+	return nil
+}
+
+// insertRuleOwners - сохраняет владельцев правила (пользователей и подгруппы)
+func insertRuleOwners(ctx context.Context, tx *sql.Tx, rule *models.OwnershipRule) error {
+	for _, userID := range rule.UserIDs {
+		res, err := execSQL(ctx, tx, `
+			INSERT INTO code_owner_rule_owners(rule_id, user_id)
+			SELECT $1, id FROM users WHERE id=$2`, rule.ID, userID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to insert rule owner", zap.Error(err), zap.Int("rule_id", rule.ID), zap.Int("user_id", userID))
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("not found: user u%d", userID)
+		}
+	}
+	for _, teamName := range rule.OwnerTeams {
+		res, err := execSQL(ctx, tx, `
+			INSERT INTO code_owner_rule_owners(rule_id, owner_team_id)
+			SELECT $1, id FROM teams WHERE name=$2`, rule.ID, teamName)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to insert rule owner team", zap.Error(err), zap.Int("rule_id", rule.ID), zap.String("owner_team", teamName))
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("not found: team '%s'", teamName)
+		}
+	}
+	return nil
+}
+
+// ListRules - правила команды в порядке применения
+func (r *CodeOwnersRepository) ListRules(ctx context.Context, teamName string) ([]models.OwnershipRule, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT cr.id, cr.position, cr.pattern,
+		       COALESCE(array_agg(o.user_id ORDER BY o.user_id) FILTER (WHERE o.user_id IS NOT NULL), '{}'),
+		       COALESCE(array_agg(ot.name ORDER BY ot.name) FILTER (WHERE ot.name IS NOT NULL), '{}')
+		FROM code_owner_rules cr
+		JOIN teams t ON t.id = cr.team_id
+		LEFT JOIN code_owner_rule_owners o ON o.rule_id = cr.id
+		LEFT JOIN teams ot ON ot.id = o.owner_team_id
+		WHERE t.name = $1
+		GROUP BY cr.id, cr.position, cr.pattern
+		ORDER BY cr.position, cr.id`, teamName)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query ownership rules", zap.Error(err), zap.String("team_name", teamName))
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []models.OwnershipRule{}
+	for rows.Next() {
+		rule := models.OwnershipRule{TeamName: teamName, UserIDs: []int{}, OwnerTeams: []string{}}
+		var userIDs []int64
+		if err := rows.Scan(&rule.ID, &rule.Position, &rule.Pattern, pq.Array(&userIDs), pq.Array(&rule.OwnerTeams)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan ownership rule", zap.Error(err), zap.String("team_name", teamName))
+			return nil, err
+		}
+		for _, id := range userIDs {
+			rule.UserIDs = append(rule.UserIDs, int(id))
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+// DeleteRule - удаляет правило
+func (r *CodeOwnersRepository) DeleteRule(ctx context.Context, id int) error {
+	res, err := execSQL(ctx, r.db, "DELETE FROM code_owner_rules WHERE id=$1", id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to delete ownership rule", zap.Error(err), zap.Int("rule_id", id))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Deleted ownership rule", zap.Int("rule_id", id))
+	return nil
+}
+
+// resolveOwners - владельцы изменённых файлов по правилам команды.
+// Подгруппы раскрываются в своих участников. Пустой результат - ни одно правило
+// с владельцами не совпало, и выбор идёт только по нагрузке
+func resolveOwners(ctx context.Context, tx *sql.Tx, teamID int, files []string) (map[int]bool, error) {
+	owners := make(map[int]bool)
+	if len(files) == 0 {
+		return owners, nil
+	}
+
+	rows, err := querySQL(ctx, tx, `
+		SELECT cr.pattern,
+		       COALESCE(array_agg(DISTINCT COALESCE(o.user_id, tm.user_id))
+		                FILTER (WHERE COALESCE(o.user_id, tm.user_id) IS NOT NULL), '{}')
+		FROM code_owner_rules cr
+		LEFT JOIN code_owner_rule_owners o ON o.rule_id = cr.id
+		LEFT JOIN team_members tm ON tm.team_id = o.owner_team_id
+		WHERE cr.team_id = $1
+		GROUP BY cr.id, cr.position, cr.pattern
+		ORDER BY cr.position, cr.id`, teamID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query ownership rules", zap.Error(err), zap.Int("team_id", teamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []codeowners.Rule[[]int64]
+	for rows.Next() {
+		var rule codeowners.Rule[[]int64]
+		if err := rows.Scan(&rule.Pattern, pq.Array(&rule.Owners)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan ownership rule", zap.Error(err), zap.Int("team_id", teamID))
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, ids := range codeowners.Owners(rules, files) {
+		for _, id := range ids {
+			owners[int(id)] = true
+		}
+	}
+	return owners, nil
+}
+
+// loadChangedFiles - изменённые файлы PR
+func loadChangedFiles(ctx context.Context, tx *sql.Tx, prID int) ([]string, error) {
+	rows, err := querySQL(ctx, tx, "SELECT path FROM pr_changed_files WHERE pr_id=$1 ORDER BY path", prID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query PR changed files", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		files = append(files, path)
+	}
+	return files, rows.Err()
+}
+
+// AddHandleMapping - создаёт или обновляет соответствие владельца пользователю/команде.
+// m.Handle должен быть уже нормализован (codeowners.NormalizeHandle)
+func (r *CodeOwnersRepository) AddHandleMapping(ctx context.Context, m *models.HandleMapping) error {
+	handle := m.Handle
+	var res sql.Result
+	var err error
+	if m.UserID != 0 {
+		res, err = execSQL(ctx, r.db, `
+			INSERT INTO handle_mappings(handle, user_id)
+			SELECT $1, id FROM users WHERE id=$2
+			ON CONFLICT(handle) DO UPDATE SET user_id=EXCLUDED.user_id, team_id=NULL`, handle, m.UserID)
+	} else {
+		res, err = execSQL(ctx, r.db, `
+			INSERT INTO handle_mappings(handle, team_id)
+			SELECT $1, id FROM teams WHERE name=$2
+			ON CONFLICT(handle) DO UPDATE SET team_id=EXCLUDED.team_id, user_id=NULL`, handle, m.TeamName)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to upsert handle mapping", zap.Error(err), zap.String("handle", handle))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Saved handle mapping", zap.String("handle", handle), zap.Int("user_id", m.UserID), zap.String("team_name", m.TeamName))
+	return nil
+}
+
+// ListHandleMappings - все соответствия владельцев
+func (r *CodeOwnersRepository) ListHandleMappings(ctx context.Context) ([]models.HandleMapping, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT hm.handle, COALESCE(hm.user_id, 0), COALESCE(t.name, '')
+		FROM handle_mappings hm
+		LEFT JOIN teams t ON t.id = hm.team_id
+		ORDER BY hm.handle`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query handle mappings", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	mappings := []models.HandleMapping{}
+	for rows.Next() {
+		var m models.HandleMapping
+		if err := rows.Scan(&m.Handle, &m.UserID, &m.TeamName); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan handle mapping", zap.Error(err))
+			return nil, err
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, rows.Err()
+}
+
+// DeleteHandleMapping - удаляет соответствие
+func (r *CodeOwnersRepository) DeleteHandleMapping(ctx context.Context, handle string) error {
+	res, err := execSQL(ctx, r.db, "DELETE FROM handle_mappings WHERE handle=$1", handle)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to delete handle mapping", zap.Error(err), zap.String("handle", handle))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+	return nil
+}
+
+// ResolveHandles - соответствия для набора нормализованных владельцев; неизвестных в результате нет
+func (r *CodeOwnersRepository) ResolveHandles(ctx context.Context, handles []string) (map[string]models.HandleMapping, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT hm.handle, COALESCE(hm.user_id, 0), COALESCE(t.name, '')
+		FROM handle_mappings hm
+		LEFT JOIN teams t ON t.id = hm.team_id
+		WHERE hm.handle = ANY($1)`, pq.Array(handles))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to resolve handles", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	resolved := make(map[string]models.HandleMapping)
+	for rows.Next() {
+		var m models.HandleMapping
+		if err := rows.Scan(&m.Handle, &m.UserID, &m.TeamName); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan handle mapping", zap.Error(err))
+			return nil, err
+		}
+		resolved[m.Handle] = m
+	}
+	return resolved, rows.Err()
+}
+
+// ReplaceRules - атомарно заменяет все правила команды новым списком (порядок сохраняется)
+func (r *CodeOwnersRepository) ReplaceRules(ctx context.Context, teamName string, rules []models.OwnershipRule) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx ReplaceRules", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "CodeOwnersRepository.ReplaceRules")
+		}
+	}()
+
+	var teamID int
+	err = queryRowSQL(ctx, tx, "SELECT id FROM teams WHERE name=$1 FOR UPDATE", teamName).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("not found: team '%s'", teamName)
+			return err
+		}
+		logger.FromContext(ctx).Error("Failed to get team_id", zap.Error(err), zap.String("team_name", teamName))
+		return err
+	}
+
+	if _, err = execSQL(ctx, tx, "DELETE FROM code_owner_rules WHERE team_id=$1", teamID); err != nil {
+		logger.FromContext(ctx).Error("Failed to delete old ownership rules", zap.Error(err), zap.String("team_name", teamName))
+		return err
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		rule.TeamName = teamName
+		rule.Position = i + 1
+		err = queryRowSQL(ctx, tx, `
+			INSERT INTO code_owner_rules(team_id, position, pattern)
+			VALUES($1,$2,$3) RETURNING id`, teamID, rule.Position, rule.Pattern,
+		).Scan(&rule.ID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to insert ownership rule", zap.Error(err), zap.String("pattern", rule.Pattern))
+			return err
+		}
+		if err = insertRuleOwners(ctx, tx, rule); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit ReplaceRules", zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Info("Replaced ownership rules", zap.String("team_name", teamName), zap.Int("rules", len(rules)))
+	return nil
+}
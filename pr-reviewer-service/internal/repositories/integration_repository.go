@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// IntegrationRepository - связь PR с внешними системами (GitLab) и сопоставление
+// их пользователей с нашими
+type IntegrationRepository struct {
+	db *sql.DB
+}
+
+func NewIntegrationRepository(db *sql.DB) *IntegrationRepository {
+	return &IntegrationRepository{db: db}
+}
+
+// FindPR - наш PR для MR number проекта; "not found", если MR ещё не связан
+func (r *IntegrationRepository) FindPR(ctx context.Context, provider string, projectID int64, number int) (int, error) {
+	var prID int
+	err := queryRowSQL(ctx, r.db, `
+		SELECT pr_id FROM external_prs WHERE provider=$1 AND project_id=$2 AND number=$3`,
+		provider, projectID, number).Scan(&prID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("not found")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to find external PR", zap.Error(err), zap.String("provider", provider),
+			zap.Int64("project_id", projectID), zap.Int("number", number))
+	}
+	return prID, err
+}
+
+// ResolveUser - наш пользователь по логину во внешней системе: сначала handle_mappings
+// (те же, что для CODEOWNERS), затем единственный пользователь с таким именем
+func (r *IntegrationRepository) ResolveUser(ctx context.Context, username string) (int, error) {
+	var userIDs []int64
+	err := queryRowSQL(ctx, r.db, `
+		SELECT COALESCE(
+			(SELECT ARRAY[user_id] FROM handle_mappings WHERE handle = lower($1) AND user_id IS NOT NULL),
+			(SELECT array_agg(id) FROM users WHERE lower(name) = lower($1)),
+			'{}')`, username).Scan(pq.Array(&userIDs))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to resolve external user", zap.Error(err), zap.String("username", username))
+		return 0, err
+	}
+	if len(userIDs) != 1 {
+		return 0, fmt.Errorf("not found")
+	}
+	return int(userIDs[0]), nil
+}
+
+// UserTeam - команда пользователя (при нескольких - с наименьшим id)
+func (r *IntegrationRepository) UserTeam(ctx context.Context, userID int) (int, error) {
+	var teamID int
+	err := queryRowSQL(ctx, r.db, "SELECT team_id FROM team_members WHERE user_id=$1 ORDER BY team_id LIMIT 1", userID).Scan(&teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("not found")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get user team", zap.Error(err), zap.Int("user_id", userID))
+	}
+	return teamID, err
+}
+
+// Usernames - логины пользователей во внешней системе: handle из handle_mappings
+// (не email и не команда), иначе имя пользователя
+func (r *IntegrationRepository) Usernames(ctx context.Context, userIDs []int) (map[int]string, error) {
+	ids := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, int64(id))
+	}
+
+	rows, err := querySQL(ctx, r.db, `
+		SELECT u.id, COALESCE(
+			(SELECT hm.handle FROM handle_mappings hm
+			 WHERE hm.user_id = u.id AND hm.handle NOT LIKE '%@%' AND hm.handle NOT LIKE '%/%'
+			 ORDER BY hm.handle LIMIT 1),
+			u.name)
+		FROM users u
+		WHERE u.id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get external usernames", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make(map[int]string, len(userIDs))
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// NotificationRepository - вебхуки команд, согласие пользователей на уведомления,
+// отметки о разосланных дайджестах и данные email-дайджеста
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// SetTeamWebhook - задаёт вебхук команды; пустой url удаляет его
+func (r *NotificationRepository) SetTeamWebhook(ctx context.Context, teamName, url string) error {
+	var teamID int
+	err := queryRowSQL(ctx, r.db, "SELECT id FROM teams WHERE name=$1", teamName).Scan(&teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("not found")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get team for webhook", zap.Error(err), zap.String("team_name", teamName))
+		return err
+	}
+
+	if url == "" {
+		_, err = execSQL(ctx, r.db, "DELETE FROM team_webhooks WHERE team_id=$1", teamID)
+	} else {
+		_, err = execSQL(ctx, r.db, `
+			INSERT INTO team_webhooks(team_id, url) VALUES($1,$2)
+			ON CONFLICT(team_id) DO UPDATE SET url=$2`, teamID, url)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to set team webhook", zap.Error(err), zap.String("team_name", teamName))
+	}
+	return err
+}
+
+// TeamWebhooks - все команды с вебхуком
+func (r *NotificationRepository) TeamWebhooks(ctx context.Context) ([]models.TeamWebhook, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT t.name, w.url FROM team_webhooks w JOIN teams t ON t.id = w.team_id ORDER BY t.name`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list team webhooks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hooks []models.TeamWebhook
+	for rows.Next() {
+		var h models.TeamWebhook
+		if err := rows.Scan(&h.TeamName, &h.URL); err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, h)
+	}
+	return hooks, rows.Err()
+}
+
+// GetPR - PR с вебхуком его команды
+func (r *NotificationRepository) GetPR(ctx context.Context, prID int) (*models.NotificationPR, error) {
+	pr := &models.NotificationPR{ID: prID}
+	var url sql.NullString
+	err := queryRowSQL(ctx, r.db, `
+		SELECT pr.title, pr.author_id, pr.created_at, t.name, w.url
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		LEFT JOIN team_webhooks w ON w.team_id = pr.team_id
+		WHERE pr.id=$1`, prID).Scan(&pr.Title, &pr.AuthorID, &pr.CreatedAt, &pr.TeamName, &url)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("not found")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR for notification", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, err
+	}
+	pr.WebhookURL = url.String
+	return pr, nil
+}
+
+// Users - имена и согласие на уведомления; несуществующие id пропускаются
+func (r *NotificationRepository) Users(ctx context.Context, userIDs []int) (map[int]models.NotificationUser, error) {
+	ids := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, int64(id))
+	}
+
+	rows, err := querySQL(ctx, r.db, "SELECT id, name, notifications_enabled FROM users WHERE id = ANY($1)", pq.Array(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get users for notification", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make(map[int]models.NotificationUser, len(userIDs))
+	for rows.Next() {
+		var id int
+		var u models.NotificationUser
+		if err := rows.Scan(&id, &u.Name, &u.Enabled); err != nil {
+			return nil, err
+		}
+		users[id] = u
+	}
+	return users, rows.Err()
+}
+
+func (r *NotificationRepository) SetUserNotifications(ctx context.Context, userID int, enabled bool) error {
+	res, err := execSQL(ctx, r.db, "UPDATE users SET notifications_enabled=$1 WHERE id=$2", enabled, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update user notifications", zap.Error(err), zap.Int("user_id", userID))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+	return nil
+}
+
+// ClaimDigest отмечает дайджест канала за день (YYYY-MM-DD) для адресата (команды или
+// пользователя) разосланным. false - его уже разослал этот или другой экземпляр сервиса
+func (r *NotificationRepository) ClaimDigest(ctx context.Context, channel, day, recipient string) (bool, error) {
+	res, err := execSQL(ctx, r.db, `
+		INSERT INTO notification_digests(channel, day, recipient) VALUES($1,$2,$3)
+		ON CONFLICT(channel, day, recipient) DO NOTHING`, channel, day, recipient)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to claim notification digest", zap.Error(err),
+			zap.String("channel", channel), zap.String("day", day), zap.String("recipient", recipient))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// ReleaseDigest снимает отметку ClaimDigest после неудачной отправки: дайджест адресату
+// повторится на следующем вызове
+func (r *NotificationRepository) ReleaseDigest(ctx context.Context, channel, day, recipient string) error {
+	_, err := execSQL(ctx, r.db, `
+		DELETE FROM notification_digests WHERE channel = $1 AND day = $2 AND recipient = $3`, channel, day, recipient)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to release notification digest", zap.Error(err),
+			zap.String("channel", channel), zap.String("day", day), zap.String("recipient", recipient))
+	}
+	return err
+}
+
+// ReviewerDigests - OPEN-ревью всех ревьюверов вместе с их статусом и email;
+// кому из них отправлять письмо, решает EmailDigestService
+func (r *NotificationRepository) ReviewerDigests(ctx context.Context) ([]models.ReviewerDigest, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT u.id, u.name, COALESCE(u.email, ''), u.is_active,
+		       pr.id, pr.title, a.name, prr.assigned_at
+		FROM pr_reviewers prr
+		JOIN users u ON u.id = prr.reviewer_id
+		JOIN pull_requests pr ON pr.id = prr.pr_id
+		JOIN users a ON a.id = pr.author_id
+		WHERE pr.status = 'OPEN'
+		ORDER BY u.id, prr.assigned_at, pr.id`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get reviewer digests", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var digests []models.ReviewerDigest
+	for rows.Next() {
+		var d models.ReviewerDigest
+		var pr models.PendingReview
+		if err := rows.Scan(&d.UserID, &d.Name, &d.Email, &d.IsActive, &pr.PRID, &pr.Title, &pr.AuthorName, &pr.AssignedAt); err != nil {
+			return nil, err
+		}
+		if n := len(digests); n > 0 && digests[n-1].UserID == d.UserID {
+			digests[n-1].Reviews = append(digests[n-1].Reviews, pr)
+			continue
+		}
+		d.Reviews = []models.PendingReview{pr}
+		digests = append(digests, d)
+	}
+	return digests, rows.Err()
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// recordAssignment - запись в историю назначений в рамках текущей транзакции
+func recordAssignment(ctx context.Context, tx *sql.Tx, prID, reviewerID int, action, reason string) error {
+	_, err := execSQL(ctx, tx, `
+		INSERT INTO pr_assignment_events(pr_id, reviewer_id, action, reason)
+		VALUES($1,$2,$3,$4)`, prID, reviewerID, action, reason)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to record assignment event", zap.Error(err),
+			zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID), zap.String("action", action))
+	}
+	return err
+}
+
+// GetPRDetails - PR с командой, ревьюверами (по времени назначения) и историей назначений
+func (r *PRRepository) GetPRDetails(ctx context.Context, prID int) (*models.PRDetails, error) {
+	pr, err := r.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	details := &models.PRDetails{PullRequest: *pr, Reviewers: []models.ReviewerInfo{}, History: []models.AssignmentEvent{}}
+
+	err = queryRowSQL(ctx, r.db, `
+		SELECT t.name FROM pull_requests pr JOIN teams t ON t.id = pr.team_id WHERE pr.id=$1`, prID,
+	).Scan(&details.TeamName)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR team", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, err
+	}
+
+	rows, err := querySQL(ctx, r.db, `
+		SELECT prr.reviewer_id, u.name, prr.assigned_at
+		FROM pr_reviewers prr
+		JOIN users u ON u.id = prr.reviewer_id
+		WHERE prr.pr_id=$1
+		ORDER BY prr.assigned_at, prr.reviewer_id`, prID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR reviewers", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rv models.ReviewerInfo
+		if err := rows.Scan(&rv.UserID, &rv.Username, &rv.AssignedAt); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan PR reviewer", zap.Error(err), zap.Int("pr_id", prID))
+			return nil, err
+		}
+		details.Reviewers = append(details.Reviewers, rv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	events, err := querySQL(ctx, r.db, `
+		SELECT e.reviewer_id, u.name, e.action, e.reason, e.created_at
+		FROM pr_assignment_events e
+		JOIN users u ON u.id = e.reviewer_id
+		WHERE e.pr_id=$1
+		ORDER BY e.id`, prID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get PR assignment history", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, err
+	}
+	defer events.Close()
+	for events.Next() {
+		var e models.AssignmentEvent
+		if err := events.Scan(&e.ReviewerID, &e.Username, &e.Action, &e.Reason, &e.At); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan assignment event", zap.Error(err), zap.Int("pr_id", prID))
+			return nil, err
+		}
+		details.History = append(details.History, e)
+	}
+	return details, events.Err()
+}
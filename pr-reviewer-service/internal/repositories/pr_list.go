@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// prSortColumns - выражение сортировки и тип значения в курсоре. Открытые PR при
+// сортировке по merged_at идут как "бесконечность": в конце по возрастанию, в начале по убыванию
+var prSortColumns = map[string]struct{ expr, cast string }{
+	models.PRSortCreatedAt: {"pr.created_at", "timestamptz"},
+	models.PRSortMergedAt:  {"COALESCE(pr.merged_at, 'infinity'::timestamptz)", "timestamptz"},
+	models.PRSortTitle:     {"pr.title", "text"},
+	models.PRSortStatus:    {"pr.status", "text"},
+	models.PRSortID:        {"pr.id", "int"},
+}
+
+// prCursor - позиция последнего PR страницы. Сортировка входит в курсор,
+// чтобы его нельзя было применить к списку с другим порядком
+type prCursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    int    `json:"id"`
+}
+
+func encodePRCursor(c prCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePRCursor(s string) (prCursor, error) {
+	var c prCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("BAD_REQUEST: invalid cursor")
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("BAD_REQUEST: invalid cursor")
+	}
+	return c, nil
+}
+
+// sortValue - значение ключа сортировки PR для курсора
+func sortValue(sort string, pr models.PullRequest) string {
+	switch sort {
+	case models.PRSortMergedAt:
+		if pr.MergedAt == nil {
+			return "infinity"
+		}
+		return pr.MergedAt.UTC().Format(time.RFC3339Nano)
+	case models.PRSortTitle:
+		return pr.Title
+	case models.PRSortStatus:
+		return pr.Status
+	case models.PRSortID:
+		return strconv.Itoa(pr.ID)
+	default:
+		return pr.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// ListPRs - страница PR по фильтру с keyset-пагинацией по (ключ сортировки, id).
+// Сортировка и лимит уже проверены сервисом
+func (r *PRRepository) ListPRs(ctx context.Context, f models.PRListFilter) (*models.PRListPage, error) {
+	col := prSortColumns[f.Sort]
+
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if f.Status != "" {
+		conds = append(conds, "pr.status = "+arg(f.Status))
+	}
+	if f.TeamName != "" {
+		conds = append(conds, "t.name = "+arg(f.TeamName))
+	}
+	if f.AuthorID != 0 {
+		conds = append(conds, "pr.author_id = "+arg(f.AuthorID))
+	}
+	if f.ReviewerID != 0 {
+		conds = append(conds, "EXISTS (SELECT 1 FROM pr_reviewers x WHERE x.pr_id = pr.id AND x.reviewer_id = "+arg(f.ReviewerID)+")")
+	}
+	if f.CreatedFrom != nil {
+		conds = append(conds, "pr.created_at >= "+arg(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		conds = append(conds, "pr.created_at < "+arg(*f.CreatedTo))
+	}
+	if f.MergedFrom != nil {
+		conds = append(conds, "pr.merged_at >= "+arg(*f.MergedFrom))
+	}
+	if f.MergedTo != nil {
+		conds = append(conds, "pr.merged_at < "+arg(*f.MergedTo))
+	}
+	if f.Query != "" {
+		conds = append(conds, "pr.title ILIKE "+arg("%"+escapeLike(f.Query)+"%"))
+	}
+
+	dir, cmp := "ASC", ">"
+	if f.Desc {
+		dir, cmp = "DESC", "<"
+	}
+	if f.Cursor != "" {
+		c, err := decodePRCursor(f.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.Sort != f.Sort || c.Desc != f.Desc {
+			return nil, fmt.Errorf("BAD_REQUEST: cursor was issued for a different sort order")
+		}
+		conds = append(conds, fmt.Sprintf("(%s, pr.id) %s (%s::%s, %s)", col.expr, cmp, arg(c.Value), col.cast, arg(c.ID)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	// Берём на одну строку больше, чтобы понять, есть ли следующая страница
+	query := fmt.Sprintf(`
+		SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.understaffed,
+		       COALESCE(array_agg(prr.reviewer_id ORDER BY prr.assigned_at, prr.reviewer_id) FILTER (WHERE prr.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		LEFT JOIN pr_reviewers prr ON prr.pr_id = pr.id
+		%s
+		GROUP BY pr.id
+		ORDER BY %s %s, pr.id %s
+		LIMIT %s`, where, col.expr, dir, dir, arg(f.Limit+1))
+
+	rows, err := querySQL(ctx, r.db, query, args...)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list PRs", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &models.PRListPage{PullRequests: []models.PullRequest{}}
+	for rows.Next() {
+		var pr models.PullRequest
+		var reviewers []int64
+		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.Understaffed, pq.Array(&reviewers)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan PR", zap.Error(err))
+			return nil, err
+		}
+		pr.AssignedReviewers = []int{}
+		for _, id := range reviewers {
+			pr.AssignedReviewers = append(pr.AssignedReviewers, int(id))
+		}
+		page.PullRequests = append(page.PullRequests, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(page.PullRequests) > f.Limit {
+		page.PullRequests = page.PullRequests[:f.Limit]
+		last := page.PullRequests[f.Limit-1]
+		page.NextCursor = encodePRCursor(prCursor{Sort: f.Sort, Desc: f.Desc, Value: sortValue(f.Sort, last), ID: last.ID})
+	}
+	return page, nil
+}
+
+// escapeLike экранирует спецсимволы ILIKE, чтобы "%" и "_" в поиске искались буквально
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/metrics"
 	"pr-reviewer-service/internal/models"
 	"time"
 
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -20,95 +22,137 @@ func NewPRRepository(db *sql.DB) *PRRepository {
 	return &PRRepository{db: db}
 }
 
-// Получаем нагрузку для каждого кандидата
-type candidate struct {
-	id   int
-	load int
-}
-
-// CreatePR: атомарно создаёт PR и назначает до 2 ревьюверов с балансировкой нагрузки
-func (r *PRRepository) CreatePR(title string, authorID int, teamID int) (int, error) {
-	ctx := context.Background()
+// CreatePR: атомарно создаёт PR и назначает до 2 ревьюверов с балансировкой нагрузки.
+// changedFiles (может быть пустым) сохраняются и используются для правил владения путями.
+// link (может быть nil) - MR, с которым PR связывается в той же транзакции; если MR уже
+// связан с другим PR, ничего не создаётся и возвращается "PR_EXISTS: ..."
+func (r *PRRepository) CreatePR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string, link *models.ExternalPR) (int, error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
-		logger.Logger.Error("failed to begin tx CreatePR", zap.Error(err))
+		logger.FromContext(ctx).Error("failed to begin tx CreatePR", zap.Error(err))
 		return 0, err
 	}
 	defer func() {
 		if p := recover(); p != nil {
-			_ = tx.Rollback()
+			rollback(tx, "PRRepository.CreatePR")
 			panic(p)
 		}
 	}()
 
+	// Иначе клиент получил бы ошибку внешнего ключа из INSERT
+	var authorExists, teamExists bool
+	err = queryRowSQL(ctx, tx,
+		"SELECT EXISTS(SELECT 1 FROM users WHERE id=$1), EXISTS(SELECT 1 FROM teams WHERE id=$2)",
+		authorID, teamID).Scan(&authorExists, &teamExists)
+	if err != nil {
+		rollback(tx, "PRRepository.CreatePR")
+		logger.FromContext(ctx).Error("Failed to check PR author and team", zap.Error(err))
+		return 0, err
+	}
+	if !authorExists || !teamExists {
+		rollback(tx, "PRRepository.CreatePR")
+		v := &models.ValidationError{}
+		if !authorExists {
+			v.Add("author_id", fmt.Sprintf("user u%d not found", authorID))
+		}
+		if !teamExists {
+			v.Add("team_id", fmt.Sprintf("team %d not found", teamID))
+		}
+		return 0, v
+	}
+
 	// 1. Вставляем PR
 	var prID int
-	err = tx.QueryRowContext(ctx, `
+	err = queryRowSQL(ctx, tx, `
 		INSERT INTO pull_requests(title, author_id, team_id, status)
 		VALUES($1,$2,$3,'OPEN') RETURNING id
 	`, title, authorID, teamID).Scan(&prID)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to create PR", zap.Error(err))
+		rollback(tx, "PRRepository.CreatePR")
+		logger.FromContext(ctx).Error("Failed to create PR", zap.Error(err))
 		return 0, err
 	}
 
-	// 2. Выбираем до 2 кандидатов с минимальной нагрузкой напрямую в SQL
-	rows, err := tx.QueryContext(ctx, `
-		WITH candidates AS (
-			SELECT u.id
-			FROM users u
-			JOIN team_members tm ON tm.user_id = u.id
-			WHERE tm.team_id = $1 AND u.is_active = true AND u.id <> $2
-		),
-		loads AS (
-			SELECT reviewer_id, COUNT(*) as cnt
-			FROM pr_reviewers
-			GROUP BY reviewer_id
-		)
-		SELECT c.id
-		FROM candidates c
-		LEFT JOIN loads l ON c.id = l.reviewer_id
-		ORDER BY COALESCE(l.cnt,0) ASC, RANDOM()
-		LIMIT 2
-	`, teamID, authorID)
-	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to query candidates with load", zap.Error(err))
-		return 0, err
+	// Параллельная доставка того же вебхука (в том числе на другой реплике) ждёт здесь на
+	// первичном ключе external_prs (provider, project_id, number), пока первая не закоммитится,
+	// и откатывает свой PR целиком - несвязанного PR или дубля не остаётся
+	if link != nil {
+		res, err := execSQL(ctx, tx, `
+			INSERT INTO external_prs(provider, project_id, number, pr_id) VALUES($1,$2,$3,$4)
+			ON CONFLICT (provider, project_id, number) DO NOTHING`,
+			link.Provider, link.ProjectID, link.Number, prID)
+		if err != nil {
+			rollback(tx, "PRRepository.CreatePR")
+			logger.FromContext(ctx).Error("Failed to link external PR", zap.Error(err), zap.String("provider", link.Provider),
+				zap.Int64("project_id", link.ProjectID), zap.Int("number", link.Number), zap.Int("pr_id", prID))
+			return 0, err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			rollback(tx, "PRRepository.CreatePR")
+			return 0, fmt.Errorf("PR_EXISTS: %s merge request %d!%d is already linked", link.Provider, link.ProjectID, link.Number)
+		}
 	}
-	defer rows.Close()
 
-	var selected []int
-	for rows.Next() {
-		var id int
-		if err := rows.Scan(&id); err != nil {
-			_ = tx.Rollback()
-			logger.Logger.Error("Failed to scan selected reviewer", zap.Error(err))
+	// Сохраняем изменённые файлы
+	for _, path := range changedFiles {
+		_, err = execSQL(ctx, tx, "INSERT INTO pr_changed_files(pr_id, path) VALUES($1,$2) ON CONFLICT DO NOTHING", prID, path)
+		if err != nil {
+			rollback(tx, "PRRepository.CreatePR")
+			logger.FromContext(ctx).Error("Failed to save PR changed file", zap.Error(err), zap.Int("pr_id", prID), zap.String("path", path))
 			return 0, err
 		}
-		selected = append(selected, id)
 	}
 
+	// 2. Выбираем до 2 кандидатов: сначала те, кто в рабочих часах, затем по минимальной нагрузке.
+	// Если у изменённых путей есть владельцы, хотя бы один ревьювер должен быть из них
+	cands, err := loadCandidates(ctx, tx, teamID, []int{authorID})
+	if err != nil {
+		rollback(tx, "PRRepository.CreatePR")
+		return 0, err
+	}
+	owners, err := resolveOwners(ctx, tx, teamID, changedFiles)
+	if err != nil {
+		rollback(tx, "PRRepository.CreatePR")
+		return 0, err
+	}
+	rankCandidates(cands, time.Now())
+	selected := pickReviewers(ctx, cands, owners, false, reviewersPerPR)
+
 	// 3. Вставляем выбранных ревьюверов
 	for _, reviewerID := range selected {
-		_, err := tx.ExecContext(ctx,
+		_, err := execSQL(ctx, tx,
 			"INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES($1,$2)",
 			prID, reviewerID)
 		if err != nil {
-			_ = tx.Rollback()
-			logger.Logger.Error("Failed to assign reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID))
+			rollback(tx, "PRRepository.CreatePR")
+			logger.FromContext(ctx).Error("Failed to assign reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID))
+			return 0, err
+		}
+		if err := recordAssignment(ctx, tx, prID, reviewerID, models.AssignmentAssigned, models.AssignReasonCreated); err != nil {
+			rollback(tx, "PRRepository.CreatePR")
+			return 0, err
+		}
+	}
+
+	// Не хватило кандидатов со свободной ёмкостью - помечаем PR как неукомплектованный
+	if len(selected) < reviewersPerPR {
+		_, err = execSQL(ctx, tx, "UPDATE pull_requests SET understaffed = true WHERE id=$1", prID)
+		if err != nil {
+			rollback(tx, "PRRepository.CreatePR")
+			logger.FromContext(ctx).Error("Failed to flag PR as understaffed", zap.Error(err), zap.Int("pr_id", prID))
 			return 0, err
 		}
+		logger.FromContext(ctx).Warn("PR is understaffed", zap.Int("pr_id", prID), zap.Int("reviewers", len(selected)))
 	}
 
 	// 4. Коммит транзакции
 	if err := tx.Commit(); err != nil {
-		logger.Logger.Error("Failed to commit tx CreatePR", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to commit tx CreatePR", zap.Error(err))
 		return 0, err
 	}
 
-	logger.Logger.Info("Created PR with reviewers",
+	metrics.PRsCreated.Inc()
+	logger.FromContext(ctx).Info("Created PR with reviewers",
 		zap.Int("pr_id", prID),
 		zap.Ints("reviewer_ids", selected),
 	)
@@ -117,23 +161,23 @@ func (r *PRRepository) CreatePR(title string, authorID int, teamID int) (int, er
 }
 
 // GetPR - получает PR и список ревьюверов
-func (r *PRRepository) GetPR(prID int) (*models.PullRequest, error) {
+func (r *PRRepository) GetPR(ctx context.Context, prID int) (*models.PullRequest, error) {
 	var pr models.PullRequest
-	err := r.db.QueryRow(`
-		SELECT id, title, author_id, status, created_at, merged_at
+	err := queryRowSQL(ctx, r.db, `
+		SELECT id, title, author_id, status, created_at, merged_at, understaffed
 		FROM pull_requests WHERE id=$1
-	`, prID).Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+	`, prID).Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.Understaffed)
 	if err != nil {
-		logger.Logger.Error("Failed to get PR", zap.Error(err), zap.Int("pr_id", prID))
+		logger.FromContext(ctx).Error("Failed to get PR", zap.Error(err), zap.Int("pr_id", prID))
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("not found")
 		}
 		return nil, err
 	}
 
-	rows, err := r.db.Query("SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1", prID)
+	rows, err := querySQL(ctx, r.db, "SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1 ORDER BY assigned_at, reviewer_id", prID)
 	if err != nil {
-		logger.Logger.Error("Failed to get PR reviewers", zap.Error(err), zap.Int("pr_id", prID))
+		logger.FromContext(ctx).Error("Failed to get PR reviewers", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, err
 	}
 	defer rows.Close()
@@ -141,7 +185,7 @@ func (r *PRRepository) GetPR(prID int) (*models.PullRequest, error) {
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			logger.Logger.Error("Failed to scan reviewer ID", zap.Error(err), zap.Int("pr_id", prID))
+			logger.FromContext(ctx).Error("Failed to scan reviewer ID", zap.Error(err), zap.Int("pr_id", prID))
 			return nil, err
 		}
 		pr.AssignedReviewers = append(pr.AssignedReviewers, id)
@@ -151,26 +195,25 @@ func (r *PRRepository) GetPR(prID int) (*models.PullRequest, error) {
 }
 
 // MergePR - идемпотентный merge
-func (r *PRRepository) MergePR(prID int) (*models.PullRequest, error) {
-	ctx := context.Background()
+func (r *PRRepository) MergePR(ctx context.Context, prID int) (*models.PullRequest, error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
-		logger.Logger.Error("Failed to begin tx MergePR", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to begin tx MergePR", zap.Error(err))
 		return nil, err
 	}
 	defer func() {
 		if p := recover(); p != nil {
-			_ = tx.Rollback()
+			rollback(tx, "PRRepository.MergePR")
 			panic(p)
 		}
 	}()
 
 	var status string
 	var mergedAt sql.NullTime
-	err = tx.QueryRowContext(ctx, "SELECT status, merged_at FROM pull_requests WHERE id=$1 FOR UPDATE", prID).Scan(&status, &mergedAt)
+	err = queryRowSQL(ctx, tx, "SELECT status, merged_at FROM pull_requests WHERE id=$1 FOR UPDATE", prID).Scan(&status, &mergedAt)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to select PR for merge", zap.Error(err), zap.Int("pr_id", prID))
+		rollback(tx, "PRRepository.MergePR")
+		logger.FromContext(ctx).Error("Failed to select PR for merge", zap.Error(err), zap.Int("pr_id", prID))
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("not found")
 		}
@@ -179,192 +222,175 @@ func (r *PRRepository) MergePR(prID int) (*models.PullRequest, error) {
 
 	if status == "MERGED" {
 		_ = tx.Commit()
-		return r.GetPR(prID)
+		return r.GetPR(ctx, prID)
 	}
 
 	now := time.Now().UTC()
-	_, err = tx.ExecContext(ctx, "UPDATE pull_requests SET status='MERGED', merged_at = $1 WHERE id=$2", now, prID)
+	_, err = execSQL(ctx, tx, "UPDATE pull_requests SET status='MERGED', merged_at = $1 WHERE id=$2", now, prID)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to update PR to MERGED", zap.Error(err), zap.Int("pr_id", prID))
+		rollback(tx, "PRRepository.MergePR")
+		logger.FromContext(ctx).Error("Failed to update PR to MERGED", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		logger.Logger.Error("Failed to commit MergePR", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to commit MergePR", zap.Error(err))
 		return nil, err
 	}
+	metrics.PRsMerged.Inc()
 
-	return r.GetPR(prID)
+	return r.GetPR(ctx, prID)
 }
 
 // ReassignReviewer - атомарно заменяет oldReviewerID на нового кандидата из той же команды
 // Возвращает новый reviewer id
-func (r *PRRepository) ReassignReviewer(prID int, oldReviewerID int) (int, error) {
-	ctx := context.Background()
+func (r *PRRepository) ReassignReviewer(ctx context.Context, prID int, oldReviewerID int) (int, error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
-		logger.Logger.Error("Failed to begin tx ReassignReviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		logger.FromContext(ctx).Error("Failed to begin tx ReassignReviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
 		return 0, err
 	}
 	defer func() {
 		if p := recover(); p != nil {
-			_ = tx.Rollback()
+			rollback(tx, "PRRepository.ReassignReviewer")
 			panic(p)
 		}
 	}()
 
-	logger.Logger.Info("Starting reassignment", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+	logger.FromContext(ctx).Info("Starting reassignment", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
 
 	// 1) Проверяем статус PR
 	var status string
-	err = tx.QueryRowContext(ctx, "SELECT status FROM pull_requests WHERE id=$1 FOR UPDATE", prID).Scan(&status)
+	err = queryRowSQL(ctx, tx, "SELECT status FROM pull_requests WHERE id=$1 FOR UPDATE", prID).Scan(&status)
 	if err != nil {
-		_ = tx.Rollback()
+		rollback(tx, "PRRepository.ReassignReviewer")
 		if errors.Is(err, sql.ErrNoRows) {
-			logger.Logger.Warn("PR not found", zap.Int("pr_id", prID))
+			logger.FromContext(ctx).Warn("PR not found", zap.Int("pr_id", prID))
 			return 0, fmt.Errorf("not found")
 		}
-		logger.Logger.Error("Failed to get PR status", zap.Error(err), zap.Int("pr_id", prID))
+		logger.FromContext(ctx).Error("Failed to get PR status", zap.Error(err), zap.Int("pr_id", prID))
 		return 0, err
 	}
-	logger.Logger.Info("PR status retrieved", zap.Int("pr_id", prID), zap.String("status", status))
+	logger.FromContext(ctx).Info("PR status retrieved", zap.Int("pr_id", prID), zap.String("status", status))
 	if status == "MERGED" {
-		_ = tx.Rollback()
-		logger.Logger.Warn("Cannot reassign reviewer: PR already merged", zap.Int("pr_id", prID))
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Warn("Cannot reassign reviewer: PR already merged", zap.Int("pr_id", prID))
 		return 0, fmt.Errorf("PR_MERGED: cannot reassign on merged PR")
 	}
 
 	// 2) Проверяем, что oldReviewer назначен
 	var exists int
-	err = tx.QueryRowContext(ctx, "SELECT 1 FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2", prID, oldReviewerID).Scan(&exists)
+	err = queryRowSQL(ctx, tx, "SELECT 1 FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2", prID, oldReviewerID).Scan(&exists)
 	if err != nil {
-		_ = tx.Rollback()
+		rollback(tx, "PRRepository.ReassignReviewer")
 		if errors.Is(err, sql.ErrNoRows) {
-			logger.Logger.Warn("Old reviewer not assigned to PR", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+			logger.FromContext(ctx).Warn("Old reviewer not assigned to PR", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
 			return 0, fmt.Errorf("NOT_ASSIGNED: reviewer is not assigned to this PR")
 		}
-		logger.Logger.Error("Failed to check existing reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		logger.FromContext(ctx).Error("Failed to check existing reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
 		return 0, err
 	}
-	logger.Logger.Info("Old reviewer confirmed assigned", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+	logger.FromContext(ctx).Info("Old reviewer confirmed assigned", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
 
 	/// 3) Получаем teamID PR, а не пользователя
-	var teamID int
-	err = tx.QueryRowContext(ctx, "SELECT team_id FROM pull_requests WHERE id=$1", prID).Scan(&teamID)
+	var teamID, authorID int
+	err = queryRowSQL(ctx, tx, "SELECT team_id, author_id FROM pull_requests WHERE id=$1", prID).Scan(&teamID, &authorID)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to get team ID for PR", zap.Error(err), zap.Int("pr_id", prID))
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Error("Failed to get team ID for PR", zap.Error(err), zap.Int("pr_id", prID))
 		return 0, fmt.Errorf("not found")
 	}
 
 	// 4) Получаем список текущих ревьюеров PR для исключения
-	curRows, err := tx.QueryContext(ctx, "SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1", prID)
+	curRows, err := querySQL(ctx, tx, "SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1", prID)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to query current reviewers", zap.Error(err), zap.Int("pr_id", prID))
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Error("Failed to query current reviewers", zap.Error(err), zap.Int("pr_id", prID))
 		return 0, err
 	}
 	defer curRows.Close()
-	excludeMap := map[int]struct{}{oldReviewerID: {}}
+	// Автор не ревьюит свой PR - как при создании PR и в AddReviewer
+	exclude := []int{oldReviewerID, authorID}
+	var remaining []int
 	for curRows.Next() {
 		var id int
 		if err := curRows.Scan(&id); err != nil {
-			_ = tx.Rollback()
-			logger.Logger.Error("Failed to scan current reviewer", zap.Error(err))
+			rollback(tx, "PRRepository.ReassignReviewer")
+			logger.FromContext(ctx).Error("Failed to scan current reviewer", zap.Error(err))
 			return 0, err
 		}
-		excludeMap[id] = struct{}{}
+		exclude = append(exclude, id)
+		if id != oldReviewerID {
+			remaining = append(remaining, id)
+		}
 	}
-	logger.Logger.Info("Current reviewers retrieved", zap.Int("pr_id", prID), zap.Int("exclude_count", len(excludeMap)))
+	logger.FromContext(ctx).Info("Current reviewers retrieved", zap.Int("pr_id", prID), zap.Int("exclude_count", len(exclude)))
 
 	// 5) Выбор кандидатов из той же команды
-	rows, err := tx.QueryContext(ctx, `
-		SELECT u.id
-		FROM users u
-		JOIN team_members tm ON tm.user_id = u.id
-		WHERE tm.team_id = $1 AND u.is_active = true AND u.id <> $2
-	`, teamID, oldReviewerID)
+	cands, err := loadCandidates(ctx, tx, teamID, exclude)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to query team candidates", zap.Error(err), zap.Int("team_id", teamID))
+		rollback(tx, "PRRepository.ReassignReviewer")
 		return 0, err
 	}
-	defer rows.Close()
-
-	var candidates []int
-	for rows.Next() {
-		var id int
-		if err := rows.Scan(&id); err != nil {
-			_ = tx.Rollback()
-			logger.Logger.Error("Failed to scan candidate", zap.Error(err))
-			return 0, err
-		}
-		if _, excluded := excludeMap[id]; excluded {
-			continue
-		}
-		candidates = append(candidates, id)
-	}
-	if len(candidates) == 0 {
-		_ = tx.Rollback()
-		logger.Logger.Warn("No active replacement candidates in team", zap.Int("team_id", teamID))
+	if len(cands) == 0 {
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Warn("No active replacement candidates in team", zap.Int("team_id", teamID))
+		metrics.NoCandidate.WithLabelValues("reassign").Inc()
 		return 0, fmt.Errorf("NO_CANDIDATE: no active replacement candidate in team")
 	}
-	logger.Logger.Info("Candidates retrieved", zap.Int("team_id", teamID), zap.Int("candidate_count", len(candidates)))
+	logger.FromContext(ctx).Info("Candidates retrieved", zap.Int("team_id", teamID), zap.Int("candidate_count", len(cands)))
 
-	// 6) Выбираем кандидата с минимальной нагрузкой
-	vals := ""
-	args := []interface{}{}
-	for i, cid := range candidates {
-		if i > 0 {
-			vals += ","
-		}
-		args = append(args, cid)
-		// Приводим к integer прямо в SQL
-		vals += fmt.Sprintf("($%d::int)", i+1)
-	}
-	query := fmt.Sprintf(`
-	WITH cands(id) AS (VALUES %s)
-	SELECT cands.id
-	FROM cands
-	LEFT JOIN (
-		SELECT reviewer_id, COUNT(*) as cnt
-		FROM pr_reviewers
-		GROUP BY reviewer_id
-	) r ON cands.id = r.reviewer_id
-	ORDER BY COALESCE(r.cnt,0) ASC, random()
-	LIMIT 1
-`, vals)
-
-	var newReviewerID int
-	err = tx.QueryRowContext(ctx, query, args...).Scan(&newReviewerID)
-	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to select new reviewer", zap.Error(err))
+	// 6) Выбираем кандидата: рабочие часы, затем минимальная нагрузка.
+	// Если после замены среди ревьюверов не останется владельцев изменённых путей - берём владельца
+	owners, hasOwner, err := prOwners(ctx, tx, prID, teamID, remaining)
+	if err != nil {
+		rollback(tx, "PRRepository.ReassignReviewer")
 		return 0, err
 	}
-	logger.Logger.Info("New reviewer selected", zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+	rankCandidates(cands, time.Now())
+	newReviewerID := pickReviewers(ctx, cands, owners, hasOwner, 1)[0]
+	logger.FromContext(ctx).Info("New reviewer selected", zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
 
 	// 7) Заменяем old -> new
-	_, err = tx.ExecContext(ctx, "DELETE FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2", prID, oldReviewerID)
+	_, err = execSQL(ctx, tx, "DELETE FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2", prID, oldReviewerID)
+	if err != nil {
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Error("Failed to delete old reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		return 0, err
+	}
+	_, err = execSQL(ctx, tx, "INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES($1,$2)", prID, newReviewerID)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to delete old reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Error("Failed to insert new reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+		return 0, err
+	}
+	if err := recordAssignment(ctx, tx, prID, oldReviewerID, models.AssignmentUnassigned, models.AssignReasonReassigned); err != nil {
+		rollback(tx, "PRRepository.ReassignReviewer")
+		return 0, err
+	}
+	if err := recordAssignment(ctx, tx, prID, newReviewerID, models.AssignmentAssigned, models.AssignReasonReassigned); err != nil {
+		rollback(tx, "PRRepository.ReassignReviewer")
 		return 0, err
 	}
-	_, err = tx.ExecContext(ctx, "INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES($1,$2)", prID, newReviewerID)
+
+	// 8) Пересчитываем флаг understaffed, как в AddReviewer
+	_, err = execSQL(ctx, tx, `
+		UPDATE pull_requests
+		SET understaffed = (SELECT COUNT(*) FROM pr_reviewers WHERE pr_id=$1) < $2
+		WHERE id=$1`, prID, reviewersPerPR)
 	if err != nil {
-		_ = tx.Rollback()
-		logger.Logger.Error("Failed to insert new reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+		rollback(tx, "PRRepository.ReassignReviewer")
+		logger.FromContext(ctx).Error("Failed to update understaffed flag", zap.Error(err), zap.Int("pr_id", prID))
 		return 0, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		logger.Logger.Error("Failed to commit ReassignReviewer", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to commit ReassignReviewer", zap.Error(err))
 		return 0, err
 	}
 
-	logger.Logger.Info("Successfully reassigned PR reviewer",
+	metrics.PRsReassigned.Inc()
+	logger.FromContext(ctx).Info("Successfully reassigned PR reviewer",
 		zap.Int("pr_id", prID),
 		zap.Int("old_reviewer_id", oldReviewerID),
 		zap.Int("new_reviewer_id", newReviewerID),
@@ -373,16 +399,227 @@ func (r *PRRepository) ReassignReviewer(prID int, oldReviewerID int) (int, error
 	return newReviewerID, nil
 }
 
-// GetActiveTeamMembers - возвращает активных членов команды (excludeUserID может быть 0)
-func (r *PRRepository) GetActiveTeamMembers(teamID int, excludeUserID int) ([]int, error) {
-	rows, err := r.db.Query(`
+// AddReviewer - атомарно добавляет к OPEN PR ещё одного ревьювера с минимальной нагрузкой
+// Возвращает id добавленного ревьювера
+func (r *PRRepository) AddReviewer(ctx context.Context, prID int) (int, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx AddReviewer", zap.Error(err), zap.Int("pr_id", prID))
+		return 0, err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			rollback(tx, "PRRepository.AddReviewer")
+			panic(p)
+		}
+	}()
+
+	// 1) Блокируем PR и проверяем статус
+	var status string
+	var teamID, authorID int
+	err = queryRowSQL(ctx, tx, "SELECT status, team_id, author_id FROM pull_requests WHERE id=$1 FOR UPDATE", prID).Scan(&status, &teamID, &authorID)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.FromContext(ctx).Warn("PR not found", zap.Int("pr_id", prID))
+			return 0, fmt.Errorf("not found")
+		}
+		logger.FromContext(ctx).Error("Failed to get PR for AddReviewer", zap.Error(err), zap.Int("pr_id", prID))
+		return 0, err
+	}
+	if status == "MERGED" {
+		rollback(tx, "PRRepository.AddReviewer")
+		logger.FromContext(ctx).Warn("Cannot add reviewer: PR already merged", zap.Int("pr_id", prID))
+		return 0, fmt.Errorf("PR_MERGED: cannot add reviewer to merged PR")
+	}
+
+	// 2) Кандидат из команды PR, не автор и ещё не назначенный: рабочие часы, затем минимальная нагрузка
+	exclude := []int{authorID}
+	var current []int
+	curRows, err := querySQL(ctx, tx, "SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1", prID)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		logger.FromContext(ctx).Error("Failed to query current reviewers", zap.Error(err), zap.Int("pr_id", prID))
+		return 0, err
+	}
+	defer curRows.Close()
+	for curRows.Next() {
+		var id int
+		if err := curRows.Scan(&id); err != nil {
+			rollback(tx, "PRRepository.AddReviewer")
+			logger.FromContext(ctx).Error("Failed to scan current reviewer", zap.Error(err))
+			return 0, err
+		}
+		exclude = append(exclude, id)
+		current = append(current, id)
+	}
+
+	cands, err := loadCandidates(ctx, tx, teamID, exclude)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		return 0, err
+	}
+	if len(cands) == 0 {
+		rollback(tx, "PRRepository.AddReviewer")
+		logger.FromContext(ctx).Warn("No active candidates to add as reviewer", zap.Int("pr_id", prID), zap.Int("team_id", teamID))
+		metrics.NoCandidate.WithLabelValues("add_reviewer").Inc()
+		return 0, fmt.Errorf("NO_CANDIDATE: no active candidate in team")
+	}
+	owners, hasOwner, err := prOwners(ctx, tx, prID, teamID, current)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		return 0, err
+	}
+	rankCandidates(cands, time.Now())
+	newReviewerID := pickReviewers(ctx, cands, owners, hasOwner, 1)[0]
+
+	// 3) Назначаем
+	_, err = execSQL(ctx, tx, "INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES($1,$2)", prID, newReviewerID)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		logger.FromContext(ctx).Error("Failed to insert additional reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", newReviewerID))
+		return 0, err
+	}
+	if err := recordAssignment(ctx, tx, prID, newReviewerID, models.AssignmentAssigned, models.AssignReasonAdded); err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		return 0, err
+	}
+
+	// 4) Снимаем флаг understaffed, если ревьюверов стало достаточно
+	_, err = execSQL(ctx, tx, `
+		UPDATE pull_requests
+		SET understaffed = (SELECT COUNT(*) FROM pr_reviewers WHERE pr_id=$1) < $2
+		WHERE id=$1`, prID, reviewersPerPR)
+	if err != nil {
+		rollback(tx, "PRRepository.AddReviewer")
+		logger.FromContext(ctx).Error("Failed to update understaffed flag", zap.Error(err), zap.Int("pr_id", prID))
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit AddReviewer", zap.Error(err))
+		return 0, err
+	}
+
+	logger.FromContext(ctx).Info("Added reviewer to PR", zap.Int("pr_id", prID), zap.Int("reviewer_id", newReviewerID))
+	return newReviewerID, nil
+}
+
+// prOwners - владельцы изменённых файлов PR и есть ли они среди reviewers
+func prOwners(ctx context.Context, tx *sql.Tx, prID, teamID int, reviewers []int) (map[int]bool, bool, error) {
+	files, err := loadChangedFiles(ctx, tx, prID)
+	if err != nil {
+		return nil, false, err
+	}
+	owners, err := resolveOwners(ctx, tx, teamID, files)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, id := range reviewers {
+		if owners[id] {
+			return owners, true, nil
+		}
+	}
+	return owners, false, nil
+}
+
+// OpenReviewLoad - число OPEN PR на каждом ревьювере (для метрик)
+func (r *PRRepository) OpenReviewLoad(ctx context.Context) (map[int]int, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT prr.reviewer_id, COUNT(*)
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pr_id
+		WHERE pr.status = 'OPEN'
+		GROUP BY prr.reviewer_id`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query open review load", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	load := make(map[int]int)
+	for rows.Next() {
+		var reviewerID, cnt int
+		if err := rows.Scan(&reviewerID, &cnt); err != nil {
+			return nil, err
+		}
+		load[reviewerID] = cnt
+	}
+	return load, rows.Err()
+}
+
+// OpenReviewPRIDs - OPEN PR, где пользователь назначен ревьювером
+func (r *PRRepository) OpenReviewPRIDs(ctx context.Context, reviewerID int) ([]int, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT pr.id
+		FROM pull_requests pr
+		JOIN pr_reviewers prr ON prr.pr_id = pr.id
+		WHERE prr.reviewer_id = $1 AND pr.status = 'OPEN'
+		ORDER BY pr.id`, reviewerID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query open reviews", zap.Error(err), zap.Int("reviewer_id", reviewerID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListUnderstaffed - OPEN PR, которым не хватило ревьюверов (teamName может быть пустым)
+func (r *PRRepository) ListUnderstaffed(ctx context.Context, teamName string) ([]models.PullRequest, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at,
+		       COALESCE(array_agg(prr.reviewer_id ORDER BY prr.assigned_at, prr.reviewer_id) FILTER (WHERE prr.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		LEFT JOIN pr_reviewers prr ON prr.pr_id = pr.id
+		WHERE pr.status = 'OPEN' AND pr.understaffed AND ($1 = '' OR t.name = $1)
+		GROUP BY pr.id
+		ORDER BY pr.created_at, pr.id
+	`, teamName)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query understaffed PRs", zap.Error(err), zap.String("team_name", teamName))
+		return nil, err
+	}
+	defer rows.Close()
+
+	prs := []models.PullRequest{}
+	for rows.Next() {
+		pr := models.PullRequest{Understaffed: true}
+		var reviewers []int64
+		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, pq.Array(&reviewers)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan understaffed PR", zap.Error(err))
+			return nil, err
+		}
+		pr.AssignedReviewers = []int{}
+		for _, id := range reviewers {
+			pr.AssignedReviewers = append(pr.AssignedReviewers, int(id))
+		}
+		prs = append(prs, pr)
+	}
+
+	logger.FromContext(ctx).Info("Retrieved understaffed PRs", zap.String("team_name", teamName), zap.Int("count", len(prs)))
+	return prs, rows.Err()
+}
+
+// GetActiveTeamMembers - возвращает доступных членов команды (excludeUserID может быть 0)
+func (r *PRRepository) GetActiveTeamMembers(ctx context.Context, teamID int, excludeUserID int) ([]int, error) {
+	rows, err := querySQL(ctx, r.db, `
 		SELECT u.id
 		FROM users u
 		JOIN team_members tm ON tm.user_id = u.id
-		WHERE tm.team_id = $1 AND u.id <> $2 AND u.is_active = true
+		WHERE tm.team_id = $1 AND u.id <> $2 AND `+availableCandidateCond+`
 	`, teamID, excludeUserID)
 	if err != nil {
-		logger.Logger.Error("Failed to get active team members", zap.Error(err), zap.Int("team_id", teamID))
+		logger.FromContext(ctx).Error("Failed to get active team members", zap.Error(err), zap.Int("team_id", teamID))
 		return nil, err
 	}
 	defer rows.Close()
@@ -391,12 +628,12 @@ func (r *PRRepository) GetActiveTeamMembers(teamID int, excludeUserID int) ([]in
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			logger.Logger.Error("Failed to scan team member ID", zap.Error(err), zap.Int("team_id", teamID))
+			logger.FromContext(ctx).Error("Failed to scan team member ID", zap.Error(err), zap.Int("team_id", teamID))
 			return nil, err
 		}
 		members = append(members, id)
 	}
 
-	logger.Logger.Info("Retrieved active team members", zap.Int("team_id", teamID), zap.Int("exclude_user_id", excludeUserID), zap.Int("count", len(members)))
-	return members, nil
+	logger.FromContext(ctx).Info("Retrieved active team members", zap.Int("team_id", teamID), zap.Int("exclude_user_id", excludeUserID), zap.Int("count", len(members)))
+	return members, rows.Err()
 }
@@ -0,0 +1,521 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"strings"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// ScimRepository - пользователи и команды в представлении SCIM. Удалять пользователей
+// и команды с PR нельзя (FK), поэтому удаление в SCIM - деактивация и отвязка
+type ScimRepository struct {
+	db *sql.DB
+}
+
+func NewScimRepository(db *sql.DB) *ScimRepository {
+	return &ScimRepository{db: db}
+}
+
+// Условия фильтра SCIM; userName по RFC 7643 регистронезависим
+var (
+	scimUserFilters  = map[string]string{"userName": "lower(u.name) = lower($1)", "externalId": "u.external_id = $1"}
+	scimGroupFilters = map[string]string{"displayName": "t.name = $1", "externalId": "t.external_id = $1"}
+)
+
+func (r *ScimRepository) GetUser(ctx context.Context, id int) (*models.ScimUser, error) {
+	users, err := selectScimUsers(ctx, r.db, "u.id = $1", []any{id}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("not found")
+	}
+	return &users[0], nil
+}
+
+// ListUsers - страница пользователей по id и общее число подходящих под фильтр
+func (r *ScimRepository) ListUsers(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimUser, int, error) {
+	where, args, err := scimWhere(filter, scimUserFilters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int
+	if err := queryRowSQL(ctx, r.db, "SELECT COUNT(*) FROM users u WHERE "+where, args...).Scan(&total); err != nil {
+		logger.FromContext(ctx).Error("Failed to count SCIM users", zap.Error(err))
+		return nil, 0, err
+	}
+	users, err := selectScimUsers(ctx, r.db, where, args, limit, offset)
+	return users, total, err
+}
+
+func selectScimUsers(ctx context.Context, q querier, where string, args []any, limit, offset int) ([]models.ScimUser, error) {
+	rows, err := querySQL(ctx, q, fmt.Sprintf(`
+		SELECT u.id, u.name, COALESCE(u.external_id, ''), u.is_active,
+		       COALESCE(array_agg(t.id ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL), '{}'),
+		       COALESCE(array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL), '{}')
+		FROM users u
+		LEFT JOIN team_members tm ON tm.user_id = u.id
+		LEFT JOIN teams t ON t.id = tm.team_id
+		WHERE %s
+		GROUP BY u.id
+		ORDER BY u.id
+		LIMIT %d OFFSET %d`, where, limit, offset), args...)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query SCIM users", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.ScimUser{}
+	for rows.Next() {
+		var u models.ScimUser
+		var teamIDs []int64
+		var teamNames []string
+		if err := rows.Scan(&u.ID, &u.UserName, &u.ExternalID, &u.Active, pq.Array(&teamIDs), pq.Array(&teamNames)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan SCIM user", zap.Error(err))
+			return nil, err
+		}
+		u.Groups = scimRefs(teamIDs, teamNames)
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// CreateUser - новый пользователь или привязка существующего с тем же именем, который
+// ещё не связан с каталогом (например, заведённого через /team/add)
+func (r *ScimRepository) CreateUser(ctx context.Context, u *models.ScimUser) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx CreateUser", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.CreateUser")
+		}
+	}()
+
+	if err = checkExternalID(ctx, tx, "users", u.ExternalID, 0); err != nil {
+		return err
+	}
+
+	var existingID int
+	var linked bool
+	err = queryRowSQL(ctx, tx, `
+		SELECT id, external_id IS NOT NULL FROM users
+		WHERE lower(name) = lower($1)
+		ORDER BY external_id IS NOT NULL, id
+		LIMIT 1
+		FOR UPDATE`, u.UserName).Scan(&existingID, &linked)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if err = syncIDSequence(ctx, tx, "users"); err != nil {
+			return err
+		}
+		err = queryRowSQL(ctx, tx, `
+			INSERT INTO users(name, is_active, external_id) VALUES($1,$2,NULLIF($3,''))
+			RETURNING id`, u.UserName, u.Active, u.ExternalID).Scan(&u.ID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to insert SCIM user", zap.Error(err), zap.String("user_name", u.UserName))
+			return err
+		}
+	case err != nil:
+		logger.FromContext(ctx).Error("Failed to look up user by name", zap.Error(err), zap.String("user_name", u.UserName))
+		return err
+	case linked:
+		err = fmt.Errorf("CONFLICT: user %q already exists", u.UserName)
+		return err
+	default:
+		u.ID = existingID
+		_, err = execSQL(ctx, tx, "UPDATE users SET name=$2, is_active=$3, external_id=NULLIF($4,'') WHERE id=$1",
+			u.ID, u.UserName, u.Active, u.ExternalID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to link SCIM user", zap.Error(err), zap.Int("user_id", u.ID))
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit CreateUser", zap.Error(err))
+		return err
+	}
+	logger.FromContext(ctx).Info("SCIM user provisioned", zap.Int("user_id", u.ID), zap.String("user_name", u.UserName))
+	return nil
+}
+
+// UpdateUser - замена атрибутов пользователя; возвращает прежнее значение is_active
+func (r *ScimRepository) UpdateUser(ctx context.Context, u *models.ScimUser) (wasActive bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx UpdateUser", zap.Error(err))
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.UpdateUser")
+		}
+	}()
+
+	err = queryRowSQL(ctx, tx, "SELECT is_active FROM users WHERE id=$1 FOR UPDATE", u.ID).Scan(&wasActive)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("not found")
+		return false, err
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get user", zap.Error(err), zap.Int("user_id", u.ID))
+		return false, err
+	}
+	if err = checkExternalID(ctx, tx, "users", u.ExternalID, u.ID); err != nil {
+		return false, err
+	}
+
+	_, err = execSQL(ctx, tx, "UPDATE users SET name=$2, is_active=$3, external_id=NULLIF($4,'') WHERE id=$1",
+		u.ID, u.UserName, u.Active, u.ExternalID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update SCIM user", zap.Error(err), zap.Int("user_id", u.ID))
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit UpdateUser", zap.Error(err))
+		return false, err
+	}
+	logger.FromContext(ctx).Info("SCIM user updated", zap.Int("user_id", u.ID), zap.Bool("active", u.Active))
+	return wasActive, nil
+}
+
+// DeleteUser - деактивирует пользователя, исключает из всех команд и отвязывает от каталога.
+// История PR остаётся за ним. Возвращает прежнее значение is_active
+func (r *ScimRepository) DeleteUser(ctx context.Context, id int) (wasActive bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx DeleteUser", zap.Error(err))
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.DeleteUser")
+		}
+	}()
+
+	err = queryRowSQL(ctx, tx, "SELECT is_active FROM users WHERE id=$1 FOR UPDATE", id).Scan(&wasActive)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("not found")
+		return false, err
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get user", zap.Error(err), zap.Int("user_id", id))
+		return false, err
+	}
+
+	if _, err = execSQL(ctx, tx, "UPDATE users SET is_active=false, external_id=NULL WHERE id=$1", id); err != nil {
+		logger.FromContext(ctx).Error("Failed to deprovision user", zap.Error(err), zap.Int("user_id", id))
+		return false, err
+	}
+	if _, err = execSQL(ctx, tx, "DELETE FROM team_members WHERE user_id=$1", id); err != nil {
+		logger.FromContext(ctx).Error("Failed to remove deprovisioned user from teams", zap.Error(err), zap.Int("user_id", id))
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit DeleteUser", zap.Error(err))
+		return false, err
+	}
+	logger.FromContext(ctx).Info("SCIM user deprovisioned", zap.Int("user_id", id))
+	return wasActive, nil
+}
+
+func (r *ScimRepository) GetGroup(ctx context.Context, id int) (*models.ScimGroup, error) {
+	groups, err := selectScimGroups(ctx, r.db, "t.id = $1", []any{id}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("not found")
+	}
+	return &groups[0], nil
+}
+
+func (r *ScimRepository) ListGroups(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimGroup, int, error) {
+	where, args, err := scimWhere(filter, scimGroupFilters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int
+	if err := queryRowSQL(ctx, r.db, "SELECT COUNT(*) FROM teams t WHERE "+where, args...).Scan(&total); err != nil {
+		logger.FromContext(ctx).Error("Failed to count SCIM groups", zap.Error(err))
+		return nil, 0, err
+	}
+	groups, err := selectScimGroups(ctx, r.db, where, args, limit, offset)
+	return groups, total, err
+}
+
+func selectScimGroups(ctx context.Context, q querier, where string, args []any, limit, offset int) ([]models.ScimGroup, error) {
+	rows, err := querySQL(ctx, q, fmt.Sprintf(`
+		SELECT t.id, t.name, COALESCE(t.external_id, ''),
+		       COALESCE(array_agg(u.id ORDER BY u.id) FILTER (WHERE u.id IS NOT NULL), '{}'),
+		       COALESCE(array_agg(u.name ORDER BY u.id) FILTER (WHERE u.id IS NOT NULL), '{}')
+		FROM teams t
+		LEFT JOIN team_members tm ON tm.team_id = t.id
+		LEFT JOIN users u ON u.id = tm.user_id
+		WHERE %s
+		GROUP BY t.id
+		ORDER BY t.id
+		LIMIT %d OFFSET %d`, where, limit, offset), args...)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query SCIM groups", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := []models.ScimGroup{}
+	for rows.Next() {
+		var g models.ScimGroup
+		var userIDs []int64
+		var userNames []string
+		if err := rows.Scan(&g.ID, &g.DisplayName, &g.ExternalID, pq.Array(&userIDs), pq.Array(&userNames)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan SCIM group", zap.Error(err))
+			return nil, err
+		}
+		g.Members = scimRefs(userIDs, userNames)
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// CreateGroup - новая команда или привязка существующей несвязанной команды с тем же именем.
+// Состав команды заменяется members из запроса
+func (r *ScimRepository) CreateGroup(ctx context.Context, g *models.ScimGroup) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx CreateGroup", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.CreateGroup")
+		}
+	}()
+
+	if err = checkExternalID(ctx, tx, "teams", g.ExternalID, 0); err != nil {
+		return err
+	}
+
+	var linked bool
+	err = queryRowSQL(ctx, tx, "SELECT id, external_id IS NOT NULL FROM teams WHERE name=$1 FOR UPDATE", g.DisplayName).
+		Scan(&g.ID, &linked)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = queryRowSQL(ctx, tx, "INSERT INTO teams(name, external_id) VALUES($1, NULLIF($2,'')) RETURNING id",
+			g.DisplayName, g.ExternalID).Scan(&g.ID)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to insert SCIM group", zap.Error(err), zap.String("team_name", g.DisplayName))
+			return err
+		}
+	case err != nil:
+		logger.FromContext(ctx).Error("Failed to look up team by name", zap.Error(err), zap.String("team_name", g.DisplayName))
+		return err
+	case linked:
+		err = fmt.Errorf("CONFLICT: group %q already exists", g.DisplayName)
+		return err
+	default:
+		if _, err = execSQL(ctx, tx, "UPDATE teams SET external_id=NULLIF($2,'') WHERE id=$1", g.ID, g.ExternalID); err != nil {
+			logger.FromContext(ctx).Error("Failed to link SCIM group", zap.Error(err), zap.Int("team_id", g.ID))
+			return err
+		}
+	}
+
+	if err = setTeamMembers(ctx, tx, g.ID, g.Members); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit CreateGroup", zap.Error(err))
+		return err
+	}
+	logger.FromContext(ctx).Info("SCIM group provisioned", zap.Int("team_id", g.ID), zap.String("team_name", g.DisplayName), zap.Int("members", len(g.Members)))
+	return nil
+}
+
+// UpdateGroup - замена имени, externalId и состава команды
+func (r *ScimRepository) UpdateGroup(ctx context.Context, g *models.ScimGroup) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx UpdateGroup", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.UpdateGroup")
+		}
+	}()
+
+	var exists int
+	err = queryRowSQL(ctx, tx, "SELECT 1 FROM teams WHERE id=$1 FOR UPDATE", g.ID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("not found")
+		return err
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get team", zap.Error(err), zap.Int("team_id", g.ID))
+		return err
+	}
+
+	err = queryRowSQL(ctx, tx, "SELECT 1 FROM teams WHERE name=$1 AND id<>$2", g.DisplayName, g.ID).Scan(&exists)
+	if err == nil {
+		err = fmt.Errorf("CONFLICT: group %q already exists", g.DisplayName)
+		return err
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.FromContext(ctx).Error("Failed to check team name", zap.Error(err), zap.String("team_name", g.DisplayName))
+		return err
+	}
+	if err = checkExternalID(ctx, tx, "teams", g.ExternalID, g.ID); err != nil {
+		return err
+	}
+
+	_, err = execSQL(ctx, tx, "UPDATE teams SET name=$2, external_id=NULLIF($3,'') WHERE id=$1", g.ID, g.DisplayName, g.ExternalID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update SCIM group", zap.Error(err), zap.Int("team_id", g.ID))
+		return err
+	}
+	if err = setTeamMembers(ctx, tx, g.ID, g.Members); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit UpdateGroup", zap.Error(err))
+		return err
+	}
+	logger.FromContext(ctx).Info("SCIM group updated", zap.Int("team_id", g.ID), zap.String("team_name", g.DisplayName), zap.Int("members", len(g.Members)))
+	return nil
+}
+
+// DeleteGroup - распускает команду. Команда с PR остаётся (на неё ссылаются PR), но без
+// участников и без связи с каталогом
+func (r *ScimRepository) DeleteGroup(ctx context.Context, id int) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx DeleteGroup", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "ScimRepository.DeleteGroup")
+		}
+	}()
+
+	var hasPRs bool
+	err = queryRowSQL(ctx, tx, `
+		SELECT EXISTS(SELECT 1 FROM pull_requests WHERE team_id = t.id)
+		FROM teams t WHERE t.id=$1 FOR UPDATE`, id).Scan(&hasPRs)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("not found")
+		return err
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get team", zap.Error(err), zap.Int("team_id", id))
+		return err
+	}
+
+	if _, err = execSQL(ctx, tx, "DELETE FROM team_members WHERE team_id=$1", id); err != nil {
+		logger.FromContext(ctx).Error("Failed to remove team members", zap.Error(err), zap.Int("team_id", id))
+		return err
+	}
+	if hasPRs {
+		_, err = execSQL(ctx, tx, "UPDATE teams SET external_id=NULL WHERE id=$1", id)
+	} else {
+		_, err = execSQL(ctx, tx, "DELETE FROM teams WHERE id=$1", id)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to delete SCIM group", zap.Error(err), zap.Int("team_id", id))
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit DeleteGroup", zap.Error(err))
+		return err
+	}
+	logger.FromContext(ctx).Info("SCIM group deleted", zap.Int("team_id", id), zap.Bool("kept_for_prs", hasPRs))
+	return nil
+}
+
+// setTeamMembers приводит состав команды к members; неизвестные пользователи - ошибка запроса
+func setTeamMembers(ctx context.Context, tx *sql.Tx, teamID int, members []models.ScimRef) error {
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, int64(m.ID))
+	}
+
+	var missing []int64
+	err := queryRowSQL(ctx, tx, `
+		SELECT COALESCE(array_agg(m.user_id), '{}') FROM unnest($1::int[]) AS m(user_id)
+		WHERE NOT EXISTS (SELECT 1 FROM users u WHERE u.id = m.user_id)`, pq.Array(ids)).Scan(pq.Array(&missing))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to check group members", zap.Error(err), zap.Int("team_id", teamID))
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("BAD_REQUEST: unknown members %v", missing)
+	}
+
+	if _, err := execSQL(ctx, tx, "DELETE FROM team_members WHERE team_id=$1 AND user_id <> ALL($2)", teamID, pq.Array(ids)); err != nil {
+		logger.FromContext(ctx).Error("Failed to remove group members", zap.Error(err), zap.Int("team_id", teamID))
+		return err
+	}
+	_, err = execSQL(ctx, tx, `
+		INSERT INTO team_members(team_id, user_id)
+		SELECT $1, unnest($2::int[])
+		ON CONFLICT DO NOTHING`, teamID, pq.Array(ids))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to add group members", zap.Error(err), zap.Int("team_id", teamID))
+	}
+	return err
+}
+
+// checkExternalID - externalId уникален в пределах таблицы; table - users или teams
+func checkExternalID(ctx context.Context, tx *sql.Tx, table, externalID string, selfID int) error {
+	if externalID == "" {
+		return nil
+	}
+	var exists int
+	err := queryRowSQL(ctx, tx, fmt.Sprintf("SELECT 1 FROM %s WHERE external_id=$1 AND id<>$2", table), externalID, selfID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to check externalId", zap.Error(err), zap.String("table", table))
+		return err
+	}
+	return fmt.Errorf("CONFLICT: externalId %q is already in use", externalID)
+}
+
+func scimWhere(filter *models.ScimFilter, columns map[string]string) (string, []any, error) {
+	if filter == nil {
+		return "TRUE", nil, nil
+	}
+	// Имена атрибутов SCIM регистронезависимы
+	for attr, cond := range columns {
+		if strings.EqualFold(attr, filter.Attr) {
+			return cond, []any{filter.Value}, nil
+		}
+	}
+	return "", nil, fmt.Errorf("BAD_REQUEST: filtering by %q is not supported", filter.Attr)
+}
+
+func scimRefs(ids []int64, names []string) []models.ScimRef {
+	refs := make([]models.ScimRef, 0, len(ids))
+	for i, id := range ids {
+		refs = append(refs, models.ScimRef{ID: int(id), Display: names[i]})
+	}
+	return refs
+}
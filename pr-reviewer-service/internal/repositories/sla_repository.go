@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type SLARepository struct {
+	db *sql.DB
+}
+
+func NewSLARepository(db *sql.DB) *SLARepository {
+	return &SLARepository{db: db}
+}
+
+// SetTeamSLA - создаёт или обновляет SLA команды
+func (r *SLARepository) SetTeamSLA(ctx context.Context, sla *models.TeamSLA) error {
+	res, err := execSQL(ctx, r.db, `
+		INSERT INTO team_sla(team_id, first_response_hours, policy)
+		SELECT id, $2, $3 FROM teams WHERE name=$1
+		ON CONFLICT(team_id) DO UPDATE SET first_response_hours=$2, policy=$3`,
+		sla.TeamName, sla.FirstResponseHours, sla.Policy,
+	)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to upsert team SLA", zap.Error(err), zap.String("team_name", sla.TeamName))
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.FromContext(ctx).Warn("Team not found for SLA", zap.String("team_name", sla.TeamName))
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Updated team SLA",
+		zap.String("team_name", sla.TeamName),
+		zap.Int("first_response_hours", sla.FirstResponseHours),
+		zap.String("policy", sla.Policy),
+	)
+	return nil
+}
+
+// GetTeamSLA - возвращает SLA команды
+func (r *SLARepository) GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error) {
+	sla := &models.TeamSLA{TeamName: teamName}
+	err := queryRowSQL(ctx, r.db, `
+		SELECT s.first_response_hours, s.policy
+		FROM team_sla s
+		JOIN teams t ON t.id = s.team_id
+		WHERE t.name=$1`, teamName).Scan(&sla.FirstResponseHours, &sla.Policy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("not found")
+		}
+		logger.FromContext(ctx).Error("Failed to get team SLA", zap.Error(err), zap.String("team_name", teamName))
+		return nil, err
+	}
+	return sla, nil
+}
+
+// GetOverdueReviews - неэскалированные назначения на OPEN PR, у которых срок первого ответа истёк
+// по календарному времени. Рабочие часы ревьювера сервис проверяет сам (OverdueReview.IsOverdue)
+func (r *SLARepository) GetOverdueReviews(ctx context.Context, now time.Time) ([]models.OverdueReview, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT prr.pr_id, prr.reviewer_id, pr.team_id, prr.assigned_at, s.first_response_hours, s.policy,
+		       u.time_zone, to_char(u.work_start, 'HH24:MI'), to_char(u.work_end, 'HH24:MI'), u.work_days
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pr_id
+		JOIN team_sla s ON s.team_id = pr.team_id
+		JOIN users u ON u.id = prr.reviewer_id
+		WHERE pr.status = 'OPEN'
+		  AND prr.escalated_at IS NULL
+		  AND prr.assigned_at + make_interval(hours => s.first_response_hours) <= $1
+		ORDER BY prr.assigned_at, prr.pr_id, prr.reviewer_id
+	`, now)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query overdue reviews", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overdue []models.OverdueReview
+	for rows.Next() {
+		var o models.OverdueReview
+		var days []int64
+		if err := rows.Scan(&o.PRID, &o.ReviewerID, &o.TeamID, &o.AssignedAt, &o.FirstResponseHours, &o.Policy,
+			&o.Schedule.TimeZone, &o.Schedule.WorkStart, &o.Schedule.WorkEnd, pq.Array(&days)); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan overdue review", zap.Error(err))
+			return nil, err
+		}
+		o.Schedule.UserID = o.ReviewerID
+		for _, d := range days {
+			o.Schedule.WorkDays = append(o.Schedule.WorkDays, int(d))
+		}
+		overdue = append(overdue, o)
+	}
+	return overdue, rows.Err()
+}
+
+// RecordEscalation - помечает назначение как эскалированное и пишет событие в журнал.
+// newReviewerID = 0, если ревьювер не добавлялся и не заменялся
+func (r *SLARepository) RecordEscalation(ctx context.Context, prID, reviewerID int, policy string, newReviewerID int) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to begin tx RecordEscalation", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			rollback(tx, "SLARepository.RecordEscalation")
+		}
+	}()
+
+	// После REASSIGN строки старого ревьювера уже нет - обновление просто ничего не затронет
+	_, err = execSQL(ctx, tx, "UPDATE pr_reviewers SET escalated_at = now() WHERE pr_id=$1 AND reviewer_id=$2", prID, reviewerID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to mark review escalated", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID))
+		return err
+	}
+
+	var newReviewer sql.NullInt64
+	if newReviewerID != 0 {
+		newReviewer = sql.NullInt64{Int64: int64(newReviewerID), Valid: true}
+	}
+	_, err = execSQL(ctx, tx, `
+		INSERT INTO review_escalations(pr_id, reviewer_id, policy, new_reviewer_id)
+		VALUES($1,$2,$3,$4)`, prID, reviewerID, policy, newReviewer)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to insert escalation", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID))
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.FromContext(ctx).Error("Failed to commit RecordEscalation", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+type StatsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// GetStats - сводные счётчики и нагрузка ревьюверов (по убыванию)
+func (r *StatsRepository) GetStats(ctx context.Context) (*models.ReviewStats, error) {
+	stats := &models.ReviewStats{ReviewerLoad: []models.ReviewerLoad{}}
+	err := queryRowSQL(ctx, r.db, `
+		SELECT
+			(SELECT COUNT(*) FROM teams),
+			(SELECT COUNT(*) FROM users),
+			(SELECT COUNT(*) FROM users WHERE is_active),
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN'),
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'MERGED'),
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN' AND understaffed),
+			(SELECT COALESCE(AVG(EXTRACT(EPOCH FROM merged_at - created_at)) / 3600, 0)
+			 FROM pull_requests WHERE status = 'MERGED')`,
+	).Scan(&stats.Teams, &stats.Users, &stats.ActiveUsers, &stats.OpenPRs, &stats.MergedPRs, &stats.UnderstaffedPRs, &stats.AvgMergeHours)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query stats", zap.Error(err))
+		return nil, err
+	}
+
+	rows, err := querySQL(ctx, r.db, `
+		SELECT u.id, u.name, COUNT(*)
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pr_id
+		JOIN users u ON u.id = prr.reviewer_id
+		WHERE pr.status = 'OPEN'
+		GROUP BY u.id
+		ORDER BY COUNT(*) DESC, u.id`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query reviewer load", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var l models.ReviewerLoad
+		if err := rows.Scan(&l.UserID, &l.Username, &l.OpenReviews); err != nil {
+			return nil, err
+		}
+		stats.ReviewerLoad = append(stats.ReviewerLoad, l)
+	}
+	return stats, rows.Err()
+}
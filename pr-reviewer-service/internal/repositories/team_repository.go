@@ -1,7 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"pr-reviewer-service/internal/logger"
 	"pr-reviewer-service/internal/models"
 
@@ -16,75 +18,75 @@ func NewTeamRepository(db *sql.DB) *TeamRepository {
 	return &TeamRepository{db: db}
 }
 
-func (r *TeamRepository) CreateTeam(team *models.Team) error {
-	tx, err := r.db.Begin()
+func (r *TeamRepository) CreateTeam(ctx context.Context, team *models.Team) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
-		logger.Logger.Error("Failed to begin transaction", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to begin transaction", zap.Error(err))
 		return err
 	}
 	defer func() {
 		if err != nil {
-			_ = tx.Rollback()
+			rollback(tx, "TeamRepository.CreateTeam")
 		}
 	}()
 
 	// 1. Создаём команду
-	_, err = tx.Exec("INSERT INTO teams(name) VALUES($1) ON CONFLICT(name) DO NOTHING", team.TeamName)
+	_, err = execSQL(ctx, tx, "INSERT INTO teams(name) VALUES($1) ON CONFLICT(name) DO NOTHING", team.TeamName)
 	if err != nil {
-		logger.Logger.Error("Failed to create team", zap.Error(err), zap.String("team_name", team.TeamName))
+		logger.FromContext(ctx).Error("Failed to create team", zap.Error(err), zap.String("team_name", team.TeamName))
 		return err
 	}
 
 	// Получаем team_id
 	var teamID int
-	err = tx.QueryRow("SELECT id FROM teams WHERE name=$1", team.TeamName).Scan(&teamID)
+	err = queryRowSQL(ctx, tx, "SELECT id FROM teams WHERE name=$1", team.TeamName).Scan(&teamID)
 	if err != nil {
-		logger.Logger.Error("Failed to get team_id", zap.Error(err), zap.String("team_name", team.TeamName))
+		logger.FromContext(ctx).Error("Failed to get team_id", zap.Error(err), zap.String("team_name", team.TeamName))
 		return err
 	}
 
 	// 2. Создаём/обновляем пользователей и привязываем к команде
 	for _, member := range team.Members {
-		_, err = tx.Exec(`
+		_, err = execSQL(ctx, tx, `
 			INSERT INTO users(id,name,is_active) VALUES($1,$2,$3)
 			ON CONFLICT(id) DO UPDATE SET name=$2, is_active=$3`,
 			member.UserID, member.Username, member.IsActive,
 		)
 		if err != nil {
-			logger.Logger.Error("Failed to upsert user", zap.Error(err), zap.Int("user_id", member.UserID))
+			logger.FromContext(ctx).Error("Failed to upsert user", zap.Error(err), zap.Int("user_id", member.UserID))
 			return err
 		}
 
-		_, err = tx.Exec(`
+		_, err = execSQL(ctx, tx, `
 			INSERT INTO team_members(team_id,user_id)
 			VALUES($1,$2) ON CONFLICT DO NOTHING`,
 			teamID, member.UserID,
 		)
 		if err != nil {
-			logger.Logger.Error("Failed to assign user to team", zap.Error(err), zap.Int("user_id", member.UserID), zap.String("team_name", team.TeamName))
+			logger.FromContext(ctx).Error("Failed to assign user to team", zap.Error(err), zap.Int("user_id", member.UserID), zap.String("team_name", team.TeamName))
 			return err
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		logger.Logger.Error("Failed to commit CreateTeam transaction", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to commit CreateTeam transaction", zap.Error(err))
 		return err
 	}
 
-	logger.Logger.Info("Created team with members", zap.String("team_name", team.TeamName), zap.Int("members_count", len(team.Members)))
+	logger.FromContext(ctx).Info("Created team with members", zap.String("team_name", team.TeamName), zap.Int("members_count", len(team.Members)))
 	return nil
 }
 
-func (r *TeamRepository) GetTeam(name string) (*models.Team, error) {
+func (r *TeamRepository) GetTeam(ctx context.Context, name string) (*models.Team, error) {
 	team := &models.Team{TeamName: name}
-	rows, err := r.db.Query(`
+	rows, err := querySQL(ctx, r.db, `
 		SELECT u.id, u.name, u.is_active
 		FROM users u
 		JOIN team_members tm ON tm.user_id=u.id
 		JOIN teams t ON t.id=tm.team_id
 		WHERE t.name=$1`, name)
 	if err != nil {
-		logger.Logger.Error("Failed to query team members", zap.Error(err), zap.String("team_name", name))
+		logger.FromContext(ctx).Error("Failed to query team members", zap.Error(err), zap.String("team_name", name))
 		return nil, err
 	}
 	defer rows.Close()
@@ -92,12 +94,72 @@ func (r *TeamRepository) GetTeam(name string) (*models.Team, error) {
 	for rows.Next() {
 		var m models.TeamMember
 		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive); err != nil {
-			logger.Logger.Error("Failed to scan team member", zap.Error(err), zap.String("team_name", name))
+			logger.FromContext(ctx).Error("Failed to scan team member", zap.Error(err), zap.String("team_name", name))
 			return nil, err
 		}
 		team.Members = append(team.Members, m)
 	}
 
-	logger.Logger.Info("Retrieved team", zap.String("team_name", name), zap.Int("members_count", len(team.Members)))
+	logger.FromContext(ctx).Info("Retrieved team", zap.String("team_name", name), zap.Int("members_count", len(team.Members)))
 	return team, nil
 }
+
+// SetDefaultMaxOpenReviews - лимит одновременных OPEN ревью по умолчанию для членов команды (nil - без лимита)
+func (r *TeamRepository) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, limit *int) error {
+	res, err := execSQL(ctx, r.db, "UPDATE teams SET default_max_open_reviews=$1 WHERE name=$2", limit, teamName)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update team review capacity", zap.Error(err), zap.String("team_name", teamName))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Updated team review capacity", zap.String("team_name", teamName), zap.Any("default_max_open_reviews", limit))
+	return nil
+}
+
+// ListTeams - все команды с численностью, по имени
+func (r *TeamRepository) ListTeams(ctx context.Context) ([]models.TeamSummary, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT t.name, COUNT(u.id), COUNT(u.id) FILTER (WHERE u.is_active)
+		FROM teams t
+		LEFT JOIN team_members tm ON tm.team_id = t.id
+		LEFT JOIN users u ON u.id = tm.user_id
+		GROUP BY t.id
+		ORDER BY t.name`)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list teams", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []models.TeamSummary{}
+	for rows.Next() {
+		var t models.TeamSummary
+		if err := rows.Scan(&t.TeamName, &t.Members, &t.ActiveMembers); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan team", zap.Error(err))
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	return teams, rows.Err()
+}
+
+// RemoveMember - исключает пользователя из команды. Назначенные ревью остаются за ним
+func (r *TeamRepository) RemoveMember(ctx context.Context, teamName string, userID int) error {
+	res, err := execSQL(ctx, r.db, `
+		DELETE FROM team_members tm
+		USING teams t
+		WHERE t.id = tm.team_id AND t.name = $1 AND tm.user_id = $2`, teamName, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to remove team member", zap.Error(err), zap.String("team_name", teamName), zap.Int("user_id", userID))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	logger.FromContext(ctx).Info("Removed team member", zap.String("team_name", teamName), zap.Int("user_id", userID))
+	return nil
+}
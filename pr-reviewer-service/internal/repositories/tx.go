@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/tracing"
+	"strings"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// rollback откатывает транзакцию и учитывает откат в метриках. method - "Repository.Method";
+// уже завершённая транзакция (ErrTxDone) не считается
+func rollback(tx *sql.Tx, method string) {
+	if err := tx.Rollback(); err == nil {
+		metrics.TxRollbacks.WithLabelValues(method).Inc()
+	}
+}
+
+// querier - *sql.DB или *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// syncIDSequence сдвигает SERIAL-последовательность таблицы за MAX(id): пользователи
+// из /team/add и импорта приходят со своими ID, и INSERT без ID иначе попадёт в занятый ключ.
+// Назад последовательность не уходит. table - только константа из кода
+func syncIDSequence(ctx context.Context, q querier, table string) error {
+	_, err := execSQL(ctx, q, fmt.Sprintf(`
+		SELECT setval(s::regclass, GREATEST((SELECT COALESCE(MAX(id),0)+1 FROM %[1]s), nextval(s::regclass)), false)
+		FROM pg_get_serial_sequence('%[1]s','id') s`, table))
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to advance id sequence", zap.Error(err), zap.String("table", table))
+	}
+	return err
+}
+
+// execSQL, querySQL и queryRowSQL выполняют запрос в отдельном спане с текстом SQL,
+// чтобы в трейсе было видно, какой именно запрос тормозит
+func execSQL(ctx context.Context, q querier, query string, args ...any) (sql.Result, error) {
+	ctx, span := sqlSpan(ctx, query)
+	res, err := q.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return res, err
+}
+
+func querySQL(ctx context.Context, q querier, query string, args ...any) (*sql.Rows, error) {
+	ctx, span := sqlSpan(ctx, query)
+	rows, err := q.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
+}
+
+// queryRowSQL - ошибка выполнения проявится только в Scan, поэтому в спан она не попадает
+func queryRowSQL(ctx context.Context, q querier, query string, args ...any) *sql.Row {
+	ctx, span := sqlSpan(ctx, query)
+	row := q.QueryRowContext(ctx, query, args...)
+	span.End()
+	return row
+}
+
+func sqlSpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	query = strings.TrimSpace(query)
+	op := query
+	if i := strings.IndexAny(op, " \t\n"); i > 0 {
+		op = op[:i]
+	}
+	op = strings.ToUpper(op)
+	return tracing.StartSpan(ctx, "SQL "+op,
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationName(op),
+		semconv.DBQueryText(query),
+	)
+}
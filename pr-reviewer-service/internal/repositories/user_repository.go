@@ -1,11 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"pr-reviewer-service/internal/logger"
 	"pr-reviewer-service/internal/models"
-	"time"
 
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -17,87 +20,179 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-func (r *UserRepository) SetIsActive(userID int, isActive bool) (*models.User, error) {
-	_, err := r.db.Exec("UPDATE users SET is_active=$1 WHERE id=$2", isActive, userID)
+func (r *UserRepository) SetIsActive(ctx context.Context, userID int, isActive bool) (*models.User, error) {
+	_, err := execSQL(ctx, r.db, "UPDATE users SET is_active=$1 WHERE id=$2", isActive, userID)
 	if err != nil {
-		logger.Logger.Error("Failed to update user active status", zap.Error(err), zap.Int("user_id", userID), zap.Bool("is_active", isActive))
+		logger.FromContext(ctx).Error("Failed to update user active status", zap.Error(err), zap.Int("user_id", userID), zap.Bool("is_active", isActive))
 		return nil, err
 	}
-	logger.Logger.Info("Updated user active status", zap.Int("user_id", userID), zap.Bool("is_active", isActive))
+	logger.FromContext(ctx).Info("Updated user active status", zap.Int("user_id", userID), zap.Bool("is_active", isActive))
 
 	var user models.User
-	err = r.db.QueryRow(`
+	err = queryRowSQL(ctx, r.db, `
 		SELECT u.id, u.name, t.name, u.is_active
 		FROM users u
 		LEFT JOIN team_members tm ON tm.user_id=u.id
 		LEFT JOIN teams t ON t.id=tm.team_id
 		WHERE u.id=$1`, userID).Scan(&user.ID, &user.Username, &user.TeamName, &user.IsActive)
 	if err != nil {
-		logger.Logger.Error("Failed to retrieve user after update", zap.Error(err), zap.Int("user_id", userID))
+		logger.FromContext(ctx).Error("Failed to retrieve user after update", zap.Error(err), zap.Int("user_id", userID))
 		return nil, err
 	}
 	return &user, nil
 }
 
-func (r *UserRepository) GetAssignedPRs(userID int) ([]models.PullRequest, error) {
-	rows, err := r.db.Query(`
-        SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at,
-               prr_all.reviewer_id
-        FROM pull_requests pr
-        JOIN pr_reviewers prr ON pr.id = prr.pr_id
-        LEFT JOIN pr_reviewers prr_all ON pr.id = prr_all.pr_id
-        WHERE prr.reviewer_id = $1
-        ORDER BY pr.id
-    `, userID)
+// AddUnavailability - добавляет период отсутствия пользователя
+func (r *UserRepository) AddUnavailability(ctx context.Context, u *models.Unavailability) error {
+	var exists int
+	err := queryRowSQL(ctx, r.db, "SELECT 1 FROM users WHERE id=$1", u.UserID).Scan(&exists)
 	if err != nil {
-		logger.Logger.Error("Failed to query assigned PRs", zap.Error(err), zap.Int("user_id", userID))
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("not found")
+		}
+		logger.FromContext(ctx).Error("Failed to check user", zap.Error(err), zap.Int("user_id", u.UserID))
+		return err
+	}
+
+	var reason sql.NullString
+	if u.Reason != "" {
+		reason = sql.NullString{String: u.Reason, Valid: true}
+	}
+	err = queryRowSQL(ctx, r.db, `
+		INSERT INTO user_unavailability(user_id, starts_at, ends_at, reason)
+		VALUES($1,$2,$3,$4) RETURNING id`,
+		u.UserID, u.StartsAt, u.EndsAt, reason,
+	).Scan(&u.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to insert unavailability", zap.Error(err), zap.Int("user_id", u.UserID))
+		return err
+	}
+
+	logger.FromContext(ctx).Info("Added user unavailability",
+		zap.Int("id", u.ID),
+		zap.Int("user_id", u.UserID),
+		zap.Time("starts_at", u.StartsAt),
+		zap.Time("ends_at", u.EndsAt),
+	)
+	return nil
+}
+
+// ListUnavailability - периоды отсутствия пользователя, которые ещё не закончились
+func (r *UserRepository) ListUnavailability(ctx context.Context, userID int) ([]models.Unavailability, error) {
+	rows, err := querySQL(ctx, r.db, `
+		SELECT id, user_id, starts_at, ends_at, COALESCE(reason, '')
+		FROM user_unavailability
+		WHERE user_id=$1 AND ends_at > now()
+		ORDER BY starts_at, id`, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to query unavailability", zap.Error(err), zap.Int("user_id", userID))
 		return nil, err
 	}
 	defer rows.Close()
 
-	prMap := make(map[int]*models.PullRequest)
+	periods := []models.Unavailability{}
 	for rows.Next() {
-		var prID, authorID, reviewerID sql.NullInt64
-		var title, status sql.NullString
-		var createdAt, mergedAt sql.NullTime
-
-		if err := rows.Scan(&prID, &title, &authorID, &status, &createdAt, &mergedAt, &reviewerID); err != nil {
-			logger.Logger.Error("Failed to scan assigned PR", zap.Error(err), zap.Int("user_id", userID))
+		var u models.Unavailability
+		if err := rows.Scan(&u.ID, &u.UserID, &u.StartsAt, &u.EndsAt, &u.Reason); err != nil {
+			logger.FromContext(ctx).Error("Failed to scan unavailability", zap.Error(err), zap.Int("user_id", userID))
 			return nil, err
 		}
+		periods = append(periods, u)
+	}
+	return periods, rows.Err()
+}
 
-		pid := int(prID.Int64)
-authorInt := int(authorID.Int64)
+// DeleteUnavailability - удаляет период отсутствия
+func (r *UserRepository) DeleteUnavailability(ctx context.Context, id int) error {
+	res, err := execSQL(ctx, r.db, "DELETE FROM user_unavailability WHERE id=$1", id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to delete unavailability", zap.Error(err), zap.Int("id", id))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
 
-var mergedPtr *time.Time
-if mergedAt.Valid {
-    mergedPtr = &mergedAt.Time
+	logger.FromContext(ctx).Info("Deleted user unavailability", zap.Int("id", id))
+	return nil
 }
 
-if _, exists := prMap[pid]; !exists {
-    prMap[pid] = &models.PullRequest{
-        ID:                pid,
-        Title:             title.String,
-        AuthorID:          authorInt,
-        Status:            status.String,
-        CreatedAt:         createdAt.Time,
-        MergedAt:          mergedPtr,
-        AssignedReviewers: []int{},
-    }
-}
+// SetSchedule - сохраняет часовой пояс и рабочие часы пользователя
+func (r *UserRepository) SetSchedule(ctx context.Context, sch *models.WorkSchedule) error {
+	days := make([]int64, 0, len(sch.WorkDays))
+	for _, d := range sch.WorkDays {
+		days = append(days, int64(d))
+	}
+
+	res, err := execSQL(ctx, r.db, `
+		UPDATE users SET time_zone=$1, work_start=$2, work_end=$3, work_days=$4
+		WHERE id=$5`,
+		sch.TimeZone, sch.WorkStart, sch.WorkEnd, pq.Array(days), sch.UserID,
+	)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update user schedule", zap.Error(err), zap.Int("user_id", sch.UserID))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
+	}
 
-if reviewerID.Valid {
-    prMap[pid].AssignedReviewers = append(prMap[pid].AssignedReviewers, int(reviewerID.Int64))
+	logger.FromContext(ctx).Info("Updated user schedule",
+		zap.Int("user_id", sch.UserID),
+		zap.String("time_zone", sch.TimeZone),
+		zap.String("work_start", sch.WorkStart),
+		zap.String("work_end", sch.WorkEnd),
+	)
+	return nil
 }
 
+// GetSchedule - часовой пояс и рабочие часы пользователя
+func (r *UserRepository) GetSchedule(ctx context.Context, userID int) (*models.WorkSchedule, error) {
+	sch := &models.WorkSchedule{UserID: userID}
+	var days []int64
+	err := queryRowSQL(ctx, r.db, `
+		SELECT time_zone, to_char(work_start, 'HH24:MI'), to_char(work_end, 'HH24:MI'), work_days
+		FROM users WHERE id=$1`, userID,
+	).Scan(&sch.TimeZone, &sch.WorkStart, &sch.WorkEnd, pq.Array(&days))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("not found")
+		}
+		logger.FromContext(ctx).Error("Failed to get user schedule", zap.Error(err), zap.Int("user_id", userID))
+		return nil, err
+	}
+	for _, d := range days {
+		sch.WorkDays = append(sch.WorkDays, int(d))
+	}
+	return sch, nil
+}
 
+// SetMaxOpenReviews - личный лимит одновременных OPEN ревью (nil - использовать лимит команды)
+func (r *UserRepository) SetMaxOpenReviews(ctx context.Context, userID int, limit *int) error {
+	res, err := execSQL(ctx, r.db, "UPDATE users SET max_open_reviews=$1 WHERE id=$2", limit, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update user review capacity", zap.Error(err), zap.Int("user_id", userID))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
 	}
 
-	prs := make([]models.PullRequest, 0, len(prMap))
-	for _, pr := range prMap {
-		prs = append(prs, *pr)
+	logger.FromContext(ctx).Info("Updated user review capacity", zap.Int("user_id", userID), zap.Any("max_open_reviews", limit))
+	return nil
+}
+
+// SetEmail - адрес для email-дайджеста (пустой - NULL)
+func (r *UserRepository) SetEmail(ctx context.Context, userID int, email string) error {
+	res, err := execSQL(ctx, r.db, "UPDATE users SET email=NULLIF($1, '') WHERE id=$2", email, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to update user email", zap.Error(err), zap.Int("user_id", userID))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("not found")
 	}
 
-	logger.Logger.Info("Retrieved assigned PRs", zap.Int("user_id", userID), zap.Int("prs_count", len(prs)))
-	return prs, nil
+	logger.FromContext(ctx).Info("Updated user email", zap.Int("user_id", userID), zap.Bool("set", email != ""))
+	return nil
 }
@@ -0,0 +1,140 @@
+package scheduler
+
+import (
+	"context"
+	"pr-reviewer-service/internal/logger"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// stuckAfterIntervals - задача считается зависшей, если её тик не завершался
+// дольше этого числа интервалов
+const stuckAfterIntervals = 3
+
+// Job - периодическая фоновая задача
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// JobStatus - состояние задачи для readiness-проверки
+type JobStatus struct {
+	Name       string     `json:"name"`
+	LastRunAt  *time.Time `json:"last_run_at,omitempty"`
+	LastError  string     `json:"last_error,omitempty"`
+	Healthy    bool       `json:"healthy"`
+	lastTickAt time.Time
+	interval   time.Duration
+}
+
+// Scheduler запускает каждую задачу в своей горутине по тикеру
+type Scheduler struct {
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	running bool
+	status  map[string]*JobStatus
+}
+
+func New() *Scheduler {
+	return &Scheduler{status: make(map[string]*JobStatus)}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.mu.Lock()
+	s.running = true
+	now := time.Now()
+	for _, job := range s.jobs {
+		s.status[job.Name] = &JobStatus{Name: job.Name, lastTickAt: now, interval: job.Interval}
+	}
+	s.mu.Unlock()
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+	logger.Logger.Info("Scheduler started", zap.Int("jobs", len(s.jobs)))
+}
+
+// Stop останавливает задачи и ждёт завершения текущих запусков
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	s.mu.Lock()
+	s.running = false
+	s.mu.Unlock()
+	logger.Logger.Info("Scheduler stopped")
+}
+
+// Status - запущен ли планировщик и состояние задач. Ошибка последнего запуска
+// не делает задачу нездоровой (следующий тик может пройти), а зависание - делает
+func (s *Scheduler) Status(now time.Time) (bool, []JobStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		st, ok := s.status[job.Name]
+		if !ok {
+			statuses = append(statuses, JobStatus{Name: job.Name})
+			continue
+		}
+		cp := *st
+		cp.Healthy = s.running && now.Sub(st.lastTickAt) <= stuckAfterIntervals*st.interval
+		statuses = append(statuses, cp)
+	}
+	return s.running, statuses
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	// Логи репозиториев и сервисов, вызванных задачей, помечены её именем
+	ctx = logger.WithContext(ctx, logger.Logger.With(zap.String("job", job.Name)))
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			start := time.Now()
+			err := job.Run(ctx)
+			s.record(job.Name, start, err)
+			if err != nil {
+				logger.FromContext(ctx).Error("Scheduled job failed", zap.Error(err))
+				continue
+			}
+			logger.FromContext(ctx).Debug("Scheduled job finished", zap.Duration("duration", time.Since(start)))
+		}
+	}
+}
+
+func (s *Scheduler) record(name string, start time.Time, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.status[name]
+	st.LastRunAt = &start
+	st.lastTickAt = time.Now()
+	st.LastError = ""
+	if err != nil {
+		st.LastError = err.Error()
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+)
+
+// apiKeyPrefix помогает узнать ключ сервиса в логах и секрет-сканерах
+const apiKeyPrefix = "prs_"
+
+type APIKeyService struct {
+	repo *repositories.APIKeyRepository
+	// bootstrapKey - ключ администратора из конфигурации (ADMIN_API_KEY), чтобы создать первые ключи
+	bootstrapKey string
+}
+
+func NewAPIKeyService(repo *repositories.APIKeyRepository, bootstrapKey string) *APIKeyService {
+	return &APIKeyService{repo: repo, bootstrapKey: bootstrapKey}
+}
+
+// CreateKey - генерирует ключ и сохраняет его хэш. Сам ключ возвращается только здесь
+func (s *APIKeyService) CreateKey(ctx context.Context, name, role string) (string, *models.APIKey, error) {
+	switch role {
+	case models.RoleAdmin, models.RoleBot, models.RoleReader:
+	default:
+		return "", nil, fmt.Errorf("BAD_REQUEST: unknown role %q", role)
+	}
+	if name == "" {
+		return "", nil, fmt.Errorf("BAD_REQUEST: name is required")
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, err
+	}
+	raw := apiKeyPrefix + hex.EncodeToString(buf)
+
+	key, err := s.repo.Create(ctx, name, role, hashAPIKey(raw))
+	if err != nil {
+		return "", nil, err
+	}
+	return raw, key, nil
+}
+
+// Authenticate - определяет владельца ключа
+func (s *APIKeyService) Authenticate(ctx context.Context, raw string) (*models.Principal, error) {
+	if s.bootstrapKey != "" && subtle.ConstantTimeCompare([]byte(raw), []byte(s.bootstrapKey)) == 1 {
+		return &models.Principal{Name: "bootstrap", Role: models.RoleAdmin}, nil
+	}
+
+	key, err := s.repo.FindActiveByHash(ctx, hashAPIKey(raw))
+	if err != nil {
+		if err.Error() == "not found" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", models.ErrAuthUnavailable, err)
+	}
+	return &models.Principal{Name: key.Name, Role: key.Role, KeyID: key.ID}, nil
+}
+
+func (s *APIKeyService) ListKeys(ctx context.Context) ([]models.APIKey, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *APIKeyService) RevokeKey(ctx context.Context, id int) error {
+	return s.repo.Revoke(ctx, id)
+}
+
+func hashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
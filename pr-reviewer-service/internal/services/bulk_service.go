@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+	"time"
+)
+
+type BulkService struct {
+	repo *repositories.BulkRepository
+}
+
+func NewBulkService(repo *repositories.BulkRepository) *BulkService {
+	return &BulkService{repo: repo}
+}
+
+func (s *BulkService) Export(ctx context.Context) (*models.BulkData, error) {
+	return s.repo.Export(ctx)
+}
+
+// Import проверяет данные целиком до обращения к БД; ссылки на команды, пользователей
+// и PR, которых нет ни в импорте, ни в БД, отсекает уже репозиторий
+func (s *BulkService) Import(ctx context.Context, data *models.BulkData, dryRun bool) (*models.ImportResult, error) {
+	if err := normalizeBulkData(data, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.Import(ctx, data, dryRun)
+}
+
+// normalizeBulkData валидирует записи и приводит время к точности Postgres (микросекунды),
+// иначе повторный импорт той же выгрузки давал бы ложные UPDATE
+func normalizeBulkData(data *models.BulkData, now time.Time) error {
+	teams := map[string]bool{}
+	for i, t := range data.Teams {
+		if t.TeamName == "" {
+			return fmt.Errorf("BAD_REQUEST: teams[%d]: team_name is required", i)
+		}
+		if teams[t.TeamName] {
+			return fmt.Errorf("BAD_REQUEST: teams[%d]: duplicate team %q", i, t.TeamName)
+		}
+		teams[t.TeamName] = true
+	}
+
+	users := map[int]bool{}
+	for i, u := range data.Users {
+		if u.UserID <= 0 {
+			return fmt.Errorf("BAD_REQUEST: users[%d]: user_id must be positive", i)
+		}
+		if u.Username == "" {
+			return fmt.Errorf("BAD_REQUEST: users[%d]: username is required", i)
+		}
+		if users[u.UserID] {
+			return fmt.Errorf("BAD_REQUEST: users[%d]: duplicate user u%d", i, u.UserID)
+		}
+		users[u.UserID] = true
+	}
+
+	for i, m := range data.Members {
+		if m.TeamName == "" || m.UserID <= 0 {
+			return fmt.Errorf("BAD_REQUEST: members[%d]: team_name and user_id are required", i)
+		}
+	}
+
+	prs := map[int]bool{}
+	for i := range data.PullRequests {
+		pr := &data.PullRequests[i]
+		if pr.ID <= 0 {
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: pull_request_id must be positive", i)
+		}
+		if prs[pr.ID] {
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: duplicate PR pr-%d", i, pr.ID)
+		}
+		prs[pr.ID] = true
+		if pr.Title == "" || pr.AuthorID <= 0 || pr.TeamName == "" {
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: title, author_id and team_name are required", i)
+		}
+		if pr.Status == "" {
+			pr.Status = "OPEN"
+		}
+		switch {
+		case pr.Status != "OPEN" && pr.Status != "MERGED":
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: status must be OPEN or MERGED", i)
+		case pr.Status == "MERGED" && pr.MergedAt == nil:
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: merged_at is required for MERGED", i)
+		case pr.Status == "OPEN" && pr.MergedAt != nil:
+			return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: merged_at must be empty for OPEN", i)
+		}
+		if pr.CreatedAt.IsZero() {
+			pr.CreatedAt = now
+		}
+		pr.CreatedAt = pr.CreatedAt.Truncate(time.Microsecond)
+		if pr.MergedAt != nil {
+			mergedAt := pr.MergedAt.Truncate(time.Microsecond)
+			if mergedAt.Before(pr.CreatedAt) {
+				return fmt.Errorf("BAD_REQUEST: pull_requests[%d]: merged_at is before created_at", i)
+			}
+			pr.MergedAt = &mergedAt
+		}
+	}
+
+	for i := range data.Assignments {
+		a := &data.Assignments[i]
+		if a.PRID <= 0 || a.ReviewerID <= 0 {
+			return fmt.Errorf("BAD_REQUEST: assignments[%d]: pull_request_id and reviewer_id are required", i)
+		}
+		if a.AssignedAt.IsZero() {
+			a.AssignedAt = now
+		}
+		a.AssignedAt = a.AssignedAt.Truncate(time.Microsecond)
+	}
+	return nil
+}
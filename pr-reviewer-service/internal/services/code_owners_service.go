@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/codeowners"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+
+	"go.uber.org/zap"
+)
+
+type CodeOwnersService struct {
+	repo *repositories.CodeOwnersRepository
+}
+
+func NewCodeOwnersService(repo *repositories.CodeOwnersRepository) *CodeOwnersService {
+	return &CodeOwnersService{repo: repo}
+}
+
+func (s *CodeOwnersService) AddRule(ctx context.Context, rule *models.OwnershipRule) error {
+	if _, err := codeowners.Compile(rule.Pattern); err != nil {
+		return fmt.Errorf("BAD_REQUEST: %w", err)
+	}
+	return s.repo.AddRule(ctx, rule)
+}
+
+func (s *CodeOwnersService) ListRules(ctx context.Context, teamName string) ([]models.OwnershipRule, error) {
+	return s.repo.ListRules(ctx, teamName)
+}
+
+func (s *CodeOwnersService) DeleteRule(ctx context.Context, id int) error {
+	return s.repo.DeleteRule(ctx, id)
+}
+
+func (s *CodeOwnersService) AddHandleMapping(ctx context.Context, m *models.HandleMapping) error {
+	m.Handle = codeowners.NormalizeHandle(m.Handle)
+	if m.Handle == "" {
+		return fmt.Errorf("BAD_REQUEST: handle is required")
+	}
+	if (m.UserID == 0) == (m.TeamName == "") {
+		return fmt.Errorf("BAD_REQUEST: exactly one of user_id and team_name is required")
+	}
+	return s.repo.AddHandleMapping(ctx, m)
+}
+
+func (s *CodeOwnersService) ListHandleMappings(ctx context.Context) ([]models.HandleMapping, error) {
+	return s.repo.ListHandleMappings(ctx)
+}
+
+func (s *CodeOwnersService) DeleteHandleMapping(ctx context.Context, handle string) error {
+	return s.repo.DeleteHandleMapping(ctx, codeowners.NormalizeHandle(handle))
+}
+
+// ImportCodeowners - заменяет правила команды содержимым файла CODEOWNERS.
+// Владельцы сопоставляются через таблицу handle_mappings; неизвестные попадают в отчёт,
+// а правило импортируется без них (как и в CODEOWNERS, правило без владельцев
+// снимает владельцев с путей, описанных выше)
+func (s *CodeOwnersService) ImportCodeowners(ctx context.Context, teamName, content string) (*models.CodeownersImportReport, error) {
+	entries, problems := codeowners.Parse(content)
+
+	report := &models.CodeownersImportReport{
+		TeamName:       teamName,
+		UnknownHandles: []string{},
+		Problems:       []models.ImportLineError{},
+	}
+	for _, p := range problems {
+		report.Problems = append(report.Problems, models.ImportLineError{Line: p.Line, Message: p.Message})
+	}
+
+	var handles []string
+	for _, e := range entries {
+		for _, owner := range e.Owners {
+			handles = append(handles, codeowners.NormalizeHandle(owner))
+		}
+	}
+	resolved, err := s.repo.ResolveHandles(ctx, handles)
+	if err != nil {
+		return nil, err
+	}
+
+	unknown := make(map[string]bool)
+	rules := make([]models.OwnershipRule, 0, len(entries))
+	for _, e := range entries {
+		rule := models.OwnershipRule{Pattern: e.Pattern, UserIDs: []int{}, OwnerTeams: []string{}}
+		for _, owner := range e.Owners {
+			m, ok := resolved[codeowners.NormalizeHandle(owner)]
+			switch {
+			case !ok:
+				if !unknown[owner] {
+					unknown[owner] = true
+					report.UnknownHandles = append(report.UnknownHandles, owner)
+				}
+			case m.UserID != 0:
+				rule.UserIDs = append(rule.UserIDs, m.UserID)
+			default:
+				rule.OwnerTeams = append(rule.OwnerTeams, m.TeamName)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := s.repo.ReplaceRules(ctx, teamName, rules); err != nil {
+		return nil, err
+	}
+	report.ImportedRules = len(rules)
+
+	logger.FromContext(ctx).Info("Imported CODEOWNERS",
+		zap.String("team_name", teamName),
+		zap.Int("rules", report.ImportedRules),
+		zap.Int("unknown_handles", len(report.UnknownHandles)),
+		zap.Int("problems", len(report.Problems)),
+	)
+	return report, nil
+}
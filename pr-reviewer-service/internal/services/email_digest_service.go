@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/notify"
+	"pr-reviewer-service/internal/repositories"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// emailDigestStore - то, что EmailDigestService берёт из NotificationRepository
+type emailDigestStore interface {
+	ClaimDigest(ctx context.Context, channel, day, recipient string) (bool, error)
+	ReleaseDigest(ctx context.Context, channel, day, recipient string) error
+	ReviewerDigests(ctx context.Context) ([]models.ReviewerDigest, error)
+}
+
+// EmailDigestService - ежедневное письмо каждому активному ревьюверу с email
+// о его OPEN-ревью, старые назначения первыми
+type EmailDigestService struct {
+	repo      emailDigestStore
+	templates *notify.Templates
+	mailer    *notify.Mailer
+	// digestAt - время рассылки от полуночи (часовой пояс сервера); < 0 - выключена
+	digestAt time.Duration
+}
+
+// NewEmailDigestService - digestAt в формате HH:MM; пусто - без рассылки
+func NewEmailDigestService(repo *repositories.NotificationRepository, templates *notify.Templates,
+	mailer *notify.Mailer, digestAt string) (*EmailDigestService, error) {
+	at, err := parseDigestTime(digestAt)
+	if err != nil {
+		return nil, err
+	}
+	return &EmailDigestService{repo: repo, templates: templates, mailer: mailer, digestAt: at}, nil
+}
+
+// SendDigest - раз в день после digestAt. Вызывается планировщиком. Каждый адресат
+// отмечается до отправки, чтобы экземпляры сервиса не дублировали письма; при ошибке
+// отметка снимается, и письмо повторяется на следующем вызове
+func (s *EmailDigestService) SendDigest(ctx context.Context, now time.Time) error {
+	if !digestDue(now, s.digestAt) {
+		return nil
+	}
+
+	digests, err := s.repo.ReviewerDigests(ctx)
+	if err != nil {
+		return err
+	}
+	digests = digestRecipients(digests)
+	day := now.Format("2006-01-02")
+	sent, failed := 0, 0
+	for _, d := range digests {
+		recipient := fmt.Sprintf("u%d", d.UserID)
+		claimed, err := s.repo.ClaimDigest(ctx, models.DigestChannelEmail, day, recipient)
+		if err != nil {
+			failed++
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.send(ctx, d, now); err != nil {
+			logger.FromContext(ctx).Warn("Failed to send email digest", zap.Error(err), zap.Int("user_id", d.UserID))
+			// Ошибку снятия логирует репозиторий; тогда письмо за день пропадёт
+			_ = s.repo.ReleaseDigest(ctx, models.DigestChannelEmail, day, recipient)
+			failed++
+			continue
+		}
+		sent++
+	}
+	if failed > 0 {
+		return fmt.Errorf("email digest failed for %d of %d reviewers", failed, len(digests))
+	}
+	if sent > 0 {
+		logger.FromContext(ctx).Info("Email digest sent", zap.Int("reviewers", sent))
+	}
+	return nil
+}
+
+// digestRecipients - ревьюверы, которым уходит письмо: активные и с email. Отказ от чата
+// (setNotifications) на письмо не влияет: от него отказываются, удаляя адрес.
+// Ревью - от самого старого назначения
+func digestRecipients(digests []models.ReviewerDigest) []models.ReviewerDigest {
+	var recipients []models.ReviewerDigest
+	for _, d := range digests {
+		if !d.IsActive || d.Email == "" || len(d.Reviews) == 0 {
+			continue
+		}
+		sort.SliceStable(d.Reviews, func(i, j int) bool {
+			a, b := d.Reviews[i], d.Reviews[j]
+			if !a.AssignedAt.Equal(b.AssignedAt) {
+				return a.AssignedAt.Before(b.AssignedAt)
+			}
+			return a.PRID < b.PRID
+		})
+		recipients = append(recipients, d)
+	}
+	return recipients
+}
+
+func (s *EmailDigestService) send(ctx context.Context, d models.ReviewerDigest, now time.Time) error {
+	msg := &notify.Message{Event: notify.TemplateEmailDigest, Reviewer: d.Name}
+	for _, r := range d.Reviews {
+		msg.PRs = append(msg.PRs, notify.PR{
+			ID:     fmt.Sprintf("pr-%d", r.PRID),
+			Title:  r.Title,
+			Author: r.AuthorName,
+			Age:    notify.Age(now.Sub(r.AssignedAt)),
+		})
+	}
+
+	subject, err := s.templates.Render(notify.TemplateEmailDigestSubject, msg)
+	if err != nil {
+		return err
+	}
+	body, err := s.templates.Render(notify.TemplateEmailDigest, msg)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, d.Email, subject, body)
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/notify"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// smtpSink - SMTP-приёмник в процессе теста: складывает письма, адресатов из reject отклоняет
+type smtpSink struct {
+	ln     net.Listener
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	mail   []sentMail
+	reject map[string]bool
+}
+
+type sentMail struct {
+	from, to string
+	msg      *mail.Message
+	body     string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpSink{ln: ln}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.serve(t, conn)
+			}()
+		}
+	}()
+	t.Cleanup(func() {
+		ln.Close()
+		s.wg.Wait()
+	})
+	return s
+}
+
+func (s *smtpSink) port() int { return s.ln.Addr().(*net.TCPAddr).Port }
+
+func (s *smtpSink) serve(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 sink ESMTP")
+	var m sentMail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.Fields(line + " ")[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 sink")
+		case "MAIL":
+			m = sentMail{from: smtpArg(line)}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			m.to = smtpArg(line)
+			s.mu.Lock()
+			rejected := s.reject[m.to]
+			s.mu.Unlock()
+			if rejected {
+				tp.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+			msg, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(data))))
+			if err != nil {
+				t.Errorf("malformed message: %v\n%s", err, data)
+				return
+			}
+			body, _ := io.ReadAll(msg.Body)
+			m.msg, m.body = msg, string(body)
+			s.mu.Lock()
+			s.mail = append(s.mail, m)
+			s.mu.Unlock()
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+// smtpArg - адрес из "MAIL FROM:<a@b>" / "RCPT TO:<a@b>"
+func smtpArg(line string) string {
+	i, j := strings.Index(line, "<"), strings.LastIndex(line, ">")
+	if i < 0 || j < i {
+		return ""
+	}
+	return line[i+1 : j]
+}
+
+func (s *smtpSink) setReject(addrs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reject = map[string]bool{}
+	for _, a := range addrs {
+		s.reject[a] = true
+	}
+}
+
+func (s *smtpSink) sent() []sentMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]sentMail(nil), s.mail...)
+}
+
+// fakeDigestStore отдаёт заданные дайджесты; отметка о рассылке - одна на адресата в день
+type fakeDigestStore struct {
+	digests []models.ReviewerDigest
+	claimed map[string]bool
+}
+
+func (f *fakeDigestStore) ClaimDigest(_ context.Context, channel, day, recipient string) (bool, error) {
+	if f.claimed == nil {
+		f.claimed = map[string]bool{}
+	}
+	key := channel + "/" + day + "/" + recipient
+	if f.claimed[key] {
+		return false, nil
+	}
+	f.claimed[key] = true
+	return true, nil
+}
+
+func (f *fakeDigestStore) ReleaseDigest(_ context.Context, channel, day, recipient string) error {
+	delete(f.claimed, channel+"/"+day+"/"+recipient)
+	return nil
+}
+
+func (f *fakeDigestStore) ReviewerDigests(context.Context) ([]models.ReviewerDigest, error) {
+	return f.digests, nil
+}
+
+var digestNow = time.Date(2026, 3, 10, 9, 30, 0, 0, time.Local)
+
+func review(prID int, title, author string, age time.Duration) models.PendingReview {
+	return models.PendingReview{PRID: prID, Title: title, AuthorName: author, AssignedAt: digestNow.Add(-age)}
+}
+
+func testDigests() []models.ReviewerDigest {
+	return []models.ReviewerDigest{
+		{UserID: 1, Name: "alice", Email: "alice@example.com", IsActive: true,
+			Reviews: []models.PendingReview{
+				review(3, "Newest", "bob", 30*time.Minute),
+				review(1, "Oldest", "carol", 72*time.Hour),
+				review(2, "Middle", "bob", 5*time.Hour),
+			}},
+		{UserID: 2, Name: "bob", Email: "bob@example.com", IsActive: false,
+			Reviews: []models.PendingReview{review(4, "Inactive reviewer", "alice", time.Hour)}},
+		{UserID: 3, Name: "carol", IsActive: true,
+			Reviews: []models.PendingReview{review(5, "No email", "alice", time.Hour)}},
+		{UserID: 4, Name: "dave", Email: "dave@example.com", IsActive: true},
+		{UserID: 5, Name: "erin", Email: "erin@example.com", IsActive: true,
+			Reviews: []models.PendingReview{review(7, "Only one", "dave", 26*time.Hour)}},
+	}
+}
+
+func newTestEmailDigest(t *testing.T, templatesDir string) (*EmailDigestService, *fakeDigestStore, *smtpSink) {
+	t.Helper()
+	sink := newSMTPSink(t)
+	templates, err := notify.LoadTemplates(templatesDir)
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+	store := &fakeDigestStore{digests: testDigests()}
+	svc, err := NewEmailDigestService(nil, templates, notify.NewMailer("127.0.0.1", sink.port(), "", "", "reviews@example.com"), "09:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc.repo = store
+	return svc, store, sink
+}
+
+func decodeSubject(t *testing.T, m sentMail) string {
+	t.Helper()
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("decode subject: %v", err)
+	}
+	return subject
+}
+
+func TestEmailDigestRecipientsAndOrder(t *testing.T) {
+	svc, _, sink := newTestEmailDigest(t, "")
+
+	if err := svc.SendDigest(context.Background(), digestNow); err != nil {
+		t.Fatalf("SendDigest: %v", err)
+	}
+
+	sent := sink.sent()
+	var to []string
+	for _, m := range sent {
+		to = append(to, m.to)
+	}
+	// bob неактивен, у carol нет адреса, у dave нет ревью
+	if strings.Join(to, ",") != "alice@example.com,erin@example.com" {
+		t.Fatalf("recipients = %v, want alice and erin", to)
+	}
+
+	alice := sent[0]
+	if alice.from != "reviews@example.com" || alice.msg.Header.Get("To") != "alice@example.com" {
+		t.Errorf("envelope from %q, To %q", alice.from, alice.msg.Header.Get("To"))
+	}
+	oldest := strings.Index(alice.body, `pr-1 "Oldest" by carol, assigned 3d ago`)
+	middle := strings.Index(alice.body, `pr-2 "Middle" by bob, assigned 5h ago`)
+	newest := strings.Index(alice.body, `pr-3 "Newest" by bob, assigned 30m ago`)
+	if oldest < 0 || middle < 0 || newest < 0 {
+		t.Fatalf("body misses reviews:\n%s", alice.body)
+	}
+	if !(oldest < middle && middle < newest) {
+		t.Errorf("reviews are not oldest first:\n%s", alice.body)
+	}
+	for _, other := range []string{"pr-4", "pr-5", "pr-7"} {
+		if strings.Contains(alice.body, other) {
+			t.Errorf("alice's digest contains %s of another reviewer", other)
+		}
+	}
+}
+
+func TestEmailDigestOncePerDay(t *testing.T) {
+	svc, _, sink := newTestEmailDigest(t, "")
+
+	// До времени рассылки ничего не уходит
+	if err := svc.SendDigest(context.Background(), digestNow.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(sink.sent()); n != 0 {
+		t.Fatalf("sent %d emails before digest time", n)
+	}
+
+	for range 2 {
+		if err := svc.SendDigest(context.Background(), digestNow); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(sink.sent()); n != 2 {
+		t.Errorf("sent %d emails, want 2 (one digest per day)", n)
+	}
+}
+
+func TestEmailDigestRetriesFailedRecipient(t *testing.T) {
+	svc, _, sink := newTestEmailDigest(t, "")
+
+	sink.setReject("erin@example.com")
+	if err := svc.SendDigest(context.Background(), digestNow); err == nil {
+		t.Fatal("SendDigest: want error for rejected recipient")
+	}
+	if n := len(sink.sent()); n != 1 {
+		t.Fatalf("sent %d emails, want 1 (alice)", n)
+	}
+
+	// Следующий вызов за тот же день досылает только не дошедшее письмо
+	sink.setReject()
+	if err := svc.SendDigest(context.Background(), digestNow.Add(time.Minute)); err != nil {
+		t.Fatalf("SendDigest retry: %v", err)
+	}
+	sent := sink.sent()
+	if len(sent) != 2 || sent[1].to != "erin@example.com" {
+		var to []string
+		for _, m := range sent {
+			to = append(to, m.to)
+		}
+		t.Fatalf("recipients = %v, want alice then erin", to)
+	}
+}
+
+func TestEmailDigestCustomTemplates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, text string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("email_digest_subject.tmpl", "Очередь ревью: {{len .PRs}}")
+	write("email_digest.tmpl", "{{.Reviewer}}:{{range .PRs}} {{.ID}}/{{.Age}}{{end}}")
+
+	svc, _, sink := newTestEmailDigest(t, dir)
+	if err := svc.SendDigest(context.Background(), digestNow); err != nil {
+		t.Fatalf("SendDigest: %v", err)
+	}
+
+	sent := sink.sent()
+	if len(sent) != 2 {
+		t.Fatalf("sent %d emails, want 2", len(sent))
+	}
+	if got := decodeSubject(t, sent[0]); got != "Очередь ревью: 3" {
+		t.Errorf("subject = %q", got)
+	}
+	if got, want := strings.TrimSpace(strings.ReplaceAll(sent[0].body, "\r\n", "\n")), "alice: pr-1/3d pr-2/5h pr-3/30m"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+	if ct := sent[0].msg.Header.Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if got := strings.TrimSpace(sent[1].body); got != "erin: pr-7/1d" {
+		t.Errorf("second body = %q", got)
+	}
+}
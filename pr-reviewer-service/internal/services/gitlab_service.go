@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const providerGitLab = "gitlab"
+
+// Итог обработки вебхука
+const (
+	WebhookCreated = "created"
+	WebhookMerged  = "merged"
+	WebhookExists  = "exists"
+)
+
+// gitlabPRs и gitlabLinks - то, что GitLabService берёт из PRService и IntegrationRepository
+// (в тестах их заменяют фейки)
+type gitlabPRs interface {
+	CreateLinkedPR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string, link models.ExternalPR) (*models.PullRequest, error)
+	MergePR(ctx context.Context, prID int) (*models.PullRequest, error)
+}
+
+type gitlabLinks interface {
+	FindPR(ctx context.Context, provider string, projectID int64, number int) (int, error)
+	ResolveUser(ctx context.Context, username string) (int, error)
+	UserTeam(ctx context.Context, userID int) (int, error)
+	Usernames(ctx context.Context, userIDs []int) (map[int]string, error)
+}
+
+// GitLabService - MR из GitLab становятся PR сервиса: open/reopen - создание с назначением
+// ревьюверов (они же уходят обратно в MR), merge - слияние
+type GitLabService struct {
+	prService gitlabPRs
+	repo      gitlabLinks
+	client    *gitlab.Client
+}
+
+func NewGitLabService(prService *PRService, repo *repositories.IntegrationRepository, client *gitlab.Client) *GitLabService {
+	return &GitLabService{prService: prService, repo: repo, client: client}
+}
+
+// HandleMergeRequest возвращает итог и PR. Событие, которое сервис не обрабатывает
+// (другое действие, неизвестный автор), - ошибка "IGNORED: ..."
+func (s *GitLabService) HandleMergeRequest(ctx context.Context, ev *gitlab.MergeRequestEvent) (string, int, error) {
+	switch ev.ObjectAttributes.Action {
+	case gitlab.ActionOpen, gitlab.ActionReopen:
+		return s.open(ctx, ev)
+	case gitlab.ActionMerge:
+		return s.merge(ctx, ev)
+	}
+	return "", 0, fmt.Errorf("IGNORED: action %q", ev.ObjectAttributes.Action)
+}
+
+func (s *GitLabService) open(ctx context.Context, ev *gitlab.MergeRequestEvent) (string, int, error) {
+	log := logger.FromContext(ctx).With(zap.Int64("project_id", ev.Project.ID), zap.Int("mr_iid", ev.ObjectAttributes.IID))
+
+	// Повторная доставка вебхука не создаёт второй PR: уже связанный MR отсекается здесь,
+	// а параллельная доставка - ключом external_prs в транзакции CreateLinkedPR
+	prID, err := s.repo.FindPR(ctx, providerGitLab, ev.Project.ID, ev.ObjectAttributes.IID)
+	if err == nil {
+		return WebhookExists, prID, nil
+	}
+	if err.Error() != "not found" {
+		return "", 0, err
+	}
+
+	// Автор MR - не обязательно тот, кто прислал событие (reopen)
+	username := ev.User.Username
+	if ev.ObjectAttributes.AuthorID != 0 && ev.ObjectAttributes.AuthorID != ev.User.ID {
+		if username, err = s.client.Username(ctx, ev.ObjectAttributes.AuthorID); err != nil {
+			return "", 0, err
+		}
+	}
+	authorID, err := s.repo.ResolveUser(ctx, username)
+	if err != nil {
+		if err.Error() == "not found" {
+			return "", 0, fmt.Errorf("IGNORED: GitLab user %q is not mapped to a service user", username)
+		}
+		return "", 0, err
+	}
+	teamID, err := s.repo.UserTeam(ctx, authorID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return "", 0, fmt.Errorf("IGNORED: author u%d is not in any team", authorID)
+		}
+		return "", 0, err
+	}
+
+	// Без списка файлов PR всё равно создаётся, просто без учёта CODEOWNERS
+	files, err := s.client.ChangedFiles(ctx, ev.Project.ID, ev.ObjectAttributes.IID)
+	if err != nil {
+		log.Warn("Failed to fetch MR changed files", zap.Error(err))
+	}
+
+	link := models.ExternalPR{Provider: providerGitLab, ProjectID: ev.Project.ID, Number: ev.ObjectAttributes.IID}
+	pr, err := s.prService.CreateLinkedPR(ctx, ev.ObjectAttributes.Title, authorID, teamID, files, link)
+	if err != nil {
+		if !strings.HasPrefix(err.Error(), "PR_EXISTS") {
+			return "", 0, err
+		}
+		// MR успела связать параллельная доставка - отвечаем её PR
+		if prID, err = s.repo.FindPR(ctx, providerGitLab, ev.Project.ID, ev.ObjectAttributes.IID); err != nil {
+			return "", 0, err
+		}
+		return WebhookExists, prID, nil
+	}
+
+	if err := s.pushReviewers(ctx, ev, pr.AssignedReviewers); err != nil {
+		log.Warn("Failed to set MR reviewers in GitLab", zap.Error(err), zap.Int("pr_id", pr.ID))
+	}
+	log.Info("Created PR from GitLab merge request", zap.Int("pr_id", pr.ID), zap.Ints("reviewer_ids", pr.AssignedReviewers))
+	return WebhookCreated, pr.ID, nil
+}
+
+// pushReviewers - ревьюверы, которых нет в GitLab, пропускаются
+func (s *GitLabService) pushReviewers(ctx context.Context, ev *gitlab.MergeRequestEvent, reviewers []int) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+	names, err := s.repo.Usernames(ctx, reviewers)
+	if err != nil {
+		return err
+	}
+
+	var gitlabIDs []int64
+	for _, reviewerID := range reviewers {
+		id, err := s.client.UserID(ctx, names[reviewerID])
+		if err != nil {
+			logger.FromContext(ctx).Warn("Reviewer not found in GitLab", zap.Error(err), zap.Int("reviewer_id", reviewerID), zap.String("username", names[reviewerID]))
+			continue
+		}
+		gitlabIDs = append(gitlabIDs, id)
+	}
+	if len(gitlabIDs) == 0 {
+		return nil
+	}
+	return s.client.SetReviewers(ctx, ev.Project.ID, ev.ObjectAttributes.IID, gitlabIDs)
+}
+
+func (s *GitLabService) merge(ctx context.Context, ev *gitlab.MergeRequestEvent) (string, int, error) {
+	prID, err := s.repo.FindPR(ctx, providerGitLab, ev.Project.ID, ev.ObjectAttributes.IID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return "", 0, fmt.Errorf("IGNORED: merge request is not tracked")
+		}
+		return "", 0, err
+	}
+	if _, err := s.prService.MergePR(ctx, prID); err != nil {
+		return "", 0, err
+	}
+	return WebhookMerged, prID, nil
+}
@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"pr-reviewer-service/internal/gitlab"
+	"pr-reviewer-service/internal/models"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testGitLabToken = "glpat-test"
+	testProjectID   = 42
+	testMRIID       = 7
+)
+
+// fakeGitLab - минимальный GitLab API v4: diffs MR (две страницы), пользователи и смена ревьюверов
+type fakeGitLab struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	users       map[string]int64 // username -> id
+	diffsStatus int
+	reviewerIDs [][]int64 // тела PUT .../merge_requests/:iid
+	badTokens   int
+}
+
+func newFakeGitLab(t *testing.T) *fakeGitLab {
+	t.Helper()
+	g := &fakeGitLab{users: map[string]int64{"alice": 100, "bob": 201, "dave": 203}, diffsStatus: http.StatusOK}
+	mr := fmt.Sprintf("/api/v4/projects/%d/merge_requests/%d", testProjectID, testMRIID)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+mr+"/diffs", func(w http.ResponseWriter, r *http.Request) {
+		g.mu.Lock()
+		status := g.diffsStatus
+		g.mu.Unlock()
+		if status != http.StatusOK {
+			http.Error(w, `{"message":"boom"}`, status)
+			return
+		}
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"old_path":"a.go","new_path":"a.go"},{"old_path":"b.go","new_path":"c.go"}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "")
+		fmt.Fprint(w, `[{"old_path":"old.go","new_path":"old.go","deleted_file":true}]`)
+	})
+	mux.HandleFunc("GET /api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		g.mu.Lock()
+		id, ok := g.users[r.URL.Query().Get("username")]
+		g.mu.Unlock()
+		if !ok {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":%d}]`, id)
+	})
+	mux.HandleFunc("GET /api/v4/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		for name, id := range g.users {
+			if fmt.Sprint(id) == r.PathValue("id") {
+				json.NewEncoder(w).Encode(map[string]string{"username": name})
+				return
+			}
+		}
+		http.Error(w, `{"message":"404 Not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("PUT "+mr, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ReviewerIDs []int64 `json:"reviewer_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		g.mu.Lock()
+		g.reviewerIDs = append(g.reviewerIDs, body.ReviewerIDs)
+		g.mu.Unlock()
+		fmt.Fprint(w, `{}`)
+	})
+
+	g.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != testGitLabToken {
+			g.mu.Lock()
+			g.badTokens++
+			g.mu.Unlock()
+			http.Error(w, `{"message":"401 Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(g.Close)
+	return g
+}
+
+func (g *fakeGitLab) pushed() [][]int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([][]int64(nil), g.reviewerIDs...)
+}
+
+type createdPR struct {
+	title    string
+	authorID int
+	teamID   int
+	files    []string
+	link     models.ExternalPR
+}
+
+// fakeStore заменяет PRService и IntegrationRepository: связь MR с PR создаётся вместе с PR,
+// как в транзакции PRRepository.CreatePR
+type fakeStore struct {
+	mu        sync.Mutex
+	links     map[models.ExternalPR]int
+	created   []createdPR
+	merged    []int
+	reviewers []int
+	// staleFind - сколько ближайших FindPR не увидят связь (её ещё не закоммитила параллельная доставка)
+	staleFind int
+
+	users     map[string]int // логин GitLab -> пользователь сервиса
+	teams     map[int]int    // пользователь -> команда
+	usernames map[int]string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		links:     map[models.ExternalPR]int{},
+		reviewers: []int{2, 3, 4},
+		users:     map[string]int{"alice": 1, "bob": 2, "carol": 3, "dave": 4, "eve": 5},
+		teams:     map[int]int{1: 10, 2: 10, 3: 10, 4: 10},
+		usernames: map[int]string{2: "bob", 3: "carol", 4: "dave"},
+	}
+}
+
+func (f *fakeStore) CreateLinkedPR(_ context.Context, title string, authorID int, teamID int, files []string, link models.ExternalPR) (*models.PullRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.links[link]; ok {
+		return nil, fmt.Errorf("PR_EXISTS: %s merge request %d!%d is already linked", link.Provider, link.ProjectID, link.Number)
+	}
+	f.created = append(f.created, createdPR{title: title, authorID: authorID, teamID: teamID, files: files, link: link})
+	id := len(f.created)
+	f.links[link] = id
+	return &models.PullRequest{ID: id, Title: title, AuthorID: authorID, Status: "OPEN", AssignedReviewers: f.reviewers}, nil
+}
+
+func (f *fakeStore) MergePR(_ context.Context, prID int) (*models.PullRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.merged = append(f.merged, prID)
+	return &models.PullRequest{ID: prID, Status: "MERGED"}, nil
+}
+
+func (f *fakeStore) FindPR(_ context.Context, provider string, projectID int64, number int) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.staleFind > 0 {
+		f.staleFind--
+		return 0, fmt.Errorf("not found")
+	}
+	id, ok := f.links[models.ExternalPR{Provider: provider, ProjectID: projectID, Number: number}]
+	if !ok {
+		return 0, fmt.Errorf("not found")
+	}
+	return id, nil
+}
+
+func (f *fakeStore) ResolveUser(_ context.Context, username string) (int, error) {
+	if id, ok := f.users[username]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("not found")
+}
+
+func (f *fakeStore) UserTeam(_ context.Context, userID int) (int, error) {
+	if id, ok := f.teams[userID]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("not found")
+}
+
+func (f *fakeStore) Usernames(_ context.Context, userIDs []int) (map[int]string, error) {
+	names := map[int]string{}
+	for _, id := range userIDs {
+		names[id] = f.usernames[id]
+	}
+	return names, nil
+}
+
+func newTestGitLabService(t *testing.T) (*GitLabService, *fakeStore, *fakeGitLab) {
+	t.Helper()
+	gl := newFakeGitLab(t)
+	store := newFakeStore()
+	return &GitLabService{prService: store, repo: store, client: gitlab.NewClient(gl.URL+"/", testGitLabToken)}, store, gl
+}
+
+func mrEvent(action, sender string, senderID, authorID int64) *gitlab.MergeRequestEvent {
+	ev := &gitlab.MergeRequestEvent{ObjectKind: "merge_request"}
+	ev.User.ID, ev.User.Username = senderID, sender
+	ev.Project.ID = testProjectID
+	ev.ObjectAttributes.IID = testMRIID
+	ev.ObjectAttributes.Title = "Add feature"
+	ev.ObjectAttributes.Action = action
+	ev.ObjectAttributes.AuthorID = authorID
+	return ev
+}
+
+func TestGitLabOpenCreatesLinkedPRAndPushesReviewers(t *testing.T) {
+	svc, store, gl := newTestGitLabService(t)
+
+	status, prID, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionOpen, "alice", 100, 100))
+	if err != nil {
+		t.Fatalf("HandleMergeRequest: %v", err)
+	}
+	if status != WebhookCreated || prID != 1 {
+		t.Fatalf("result = %s pr-%d, want %s pr-1", status, prID, WebhookCreated)
+	}
+
+	want := createdPR{title: "Add feature", authorID: 1, teamID: 10, files: []string{"a.go", "c.go", "old.go"},
+		link: models.ExternalPR{Provider: "gitlab", ProjectID: testProjectID, Number: testMRIID}}
+	if len(store.created) != 1 || !reflect.DeepEqual(store.created[0], want) {
+		t.Fatalf("created = %+v, want [%+v]", store.created, want)
+	}
+	// carol нет в GitLab - она пропускается, остальные уходят в MR одним запросом
+	if got := gl.pushed(); !reflect.DeepEqual(got, [][]int64{{201, 203}}) {
+		t.Errorf("reviewers pushed to MR = %v, want [[201 203]]", got)
+	}
+	if gl.badTokens != 0 {
+		t.Errorf("requests without PRIVATE-TOKEN: %d", gl.badTokens)
+	}
+}
+
+func TestGitLabReopenResolvesMRAuthor(t *testing.T) {
+	svc, store, _ := newTestGitLabService(t)
+
+	// Переоткрыл MR dave, автор - alice: автор ищется в GitLab по author_id
+	status, _, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionReopen, "dave", 203, 100))
+	if err != nil {
+		t.Fatalf("HandleMergeRequest: %v", err)
+	}
+	if status != WebhookCreated {
+		t.Fatalf("status = %s, want %s", status, WebhookCreated)
+	}
+	if store.created[0].authorID != 1 {
+		t.Errorf("author = u%d, want u1 (alice)", store.created[0].authorID)
+	}
+}
+
+func TestGitLabDuplicateDelivery(t *testing.T) {
+	svc, store, gl := newTestGitLabService(t)
+	ev := mrEvent(gitlab.ActionOpen, "alice", 100, 100)
+
+	if _, _, err := svc.HandleMergeRequest(context.Background(), ev); err != nil {
+		t.Fatalf("first delivery: %v", err)
+	}
+	status, prID, err := svc.HandleMergeRequest(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("redelivery: %v", err)
+	}
+	if status != WebhookExists || prID != 1 {
+		t.Errorf("redelivery = %s pr-%d, want %s pr-1", status, prID, WebhookExists)
+	}
+
+	// Параллельная доставка (другая реплика) не увидела связь при проверке и дошла до создания
+	store.staleFind = 1
+	status, prID, err = svc.HandleMergeRequest(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("concurrent delivery: %v", err)
+	}
+	if status != WebhookExists || prID != 1 {
+		t.Errorf("concurrent delivery = %s pr-%d, want %s pr-1", status, prID, WebhookExists)
+	}
+
+	if len(store.created) != 1 {
+		t.Errorf("PRs created = %d, want 1", len(store.created))
+	}
+	if n := len(gl.pushed()); n != 1 {
+		t.Errorf("reviewer updates sent to GitLab = %d, want 1", n)
+	}
+}
+
+func TestGitLabConcurrentDeliveriesCreateOnePR(t *testing.T) {
+	svc, store, _ := newTestGitLabService(t)
+	ev := mrEvent(gitlab.ActionOpen, "alice", 100, 100)
+
+	results := make([]string, 8)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, prID, err := svc.HandleMergeRequest(context.Background(), ev)
+			results[i] = fmt.Sprintf("%s pr-%d %v", status, prID, err)
+		}()
+	}
+	wg.Wait()
+
+	if len(store.created) != 1 {
+		t.Fatalf("PRs created = %d, want 1", len(store.created))
+	}
+	created := 0
+	for _, r := range results {
+		switch r {
+		case WebhookCreated + " pr-1 <nil>":
+			created++
+		case WebhookExists + " pr-1 <nil>":
+		default:
+			t.Errorf("unexpected result %q", r)
+		}
+	}
+	if created != 1 {
+		t.Errorf("deliveries reporting %s = %d, want 1", WebhookCreated, created)
+	}
+}
+
+func TestGitLabOpenWithoutChangedFiles(t *testing.T) {
+	svc, store, gl := newTestGitLabService(t)
+	gl.diffsStatus = http.StatusInternalServerError
+
+	status, _, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionOpen, "alice", 100, 100))
+	if err != nil || status != WebhookCreated {
+		t.Fatalf("HandleMergeRequest = %s, %v; want %s", status, err, WebhookCreated)
+	}
+	if store.created[0].files != nil {
+		t.Errorf("files = %v, want none", store.created[0].files)
+	}
+}
+
+func TestGitLabMerge(t *testing.T) {
+	svc, store, _ := newTestGitLabService(t)
+
+	if _, _, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionMerge, "alice", 100, 100)); err == nil ||
+		!strings.HasPrefix(err.Error(), "IGNORED") {
+		t.Fatalf("merge of untracked MR: err = %v, want IGNORED", err)
+	}
+
+	if _, _, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionOpen, "alice", 100, 100)); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	status, prID, err := svc.HandleMergeRequest(context.Background(), mrEvent(gitlab.ActionMerge, "bob", 201, 100))
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if status != WebhookMerged || prID != 1 {
+		t.Errorf("merge = %s pr-%d, want %s pr-1", status, prID, WebhookMerged)
+	}
+	if !reflect.DeepEqual(store.merged, []int{1}) {
+		t.Errorf("merged = %v, want [1]", store.merged)
+	}
+}
+
+func TestGitLabIgnoredEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		ev     *gitlab.MergeRequestEvent
+		reason string
+	}{
+		{"other action", mrEvent("update", "alice", 100, 100), `action "update"`},
+		{"unmapped author", mrEvent(gitlab.ActionOpen, "mallory", 300, 300), `GitLab user "mallory" is not mapped`},
+		{"author without team", mrEvent(gitlab.ActionOpen, "eve", 305, 305), "author u5 is not in any team"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store, gl := newTestGitLabService(t)
+			_, _, err := svc.HandleMergeRequest(context.Background(), tt.ev)
+			if err == nil || !strings.HasPrefix(err.Error(), "IGNORED: ") || !strings.Contains(err.Error(), tt.reason) {
+				t.Fatalf("err = %v, want IGNORED with %q", err, tt.reason)
+			}
+			if len(store.created) != 0 || len(gl.pushed()) != 0 {
+				t.Errorf("ignored event changed state: created %d, pushed %v", len(store.created), gl.pushed())
+			}
+		})
+	}
+}
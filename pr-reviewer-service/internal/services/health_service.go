@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/db"
+	"pr-reviewer-service/internal/scheduler"
+	"sync/atomic"
+	"time"
+)
+
+// readinessTimeout - сколько ждём ответа БД в readiness-проверке
+const readinessTimeout = 2 * time.Second
+
+// Check - результат одной проверки готовности
+type Check struct {
+	OK      bool        `json:"ok"`
+	Message string      `json:"message,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+type HealthService struct {
+	db        *db.DB
+	scheduler *scheduler.Scheduler
+	draining  atomic.Bool
+}
+
+func NewHealthService(database *db.DB, sched *scheduler.Scheduler) *HealthService {
+	return &HealthService{db: database, scheduler: sched}
+}
+
+// SetDraining - сервис завершается: readiness сразу отвечает "не готов",
+// чтобы балансировщик перестал слать трафик до server.Shutdown
+func (s *HealthService) SetDraining() {
+	s.draining.Store(true)
+}
+
+// Ready - все проверки готовности; ready = true, только если прошли все
+func (s *HealthService) Ready(ctx context.Context) (bool, map[string]Check) {
+	checks := map[string]Check{}
+	if s.draining.Load() {
+		checks["shutdown"] = Check{OK: false, Message: "shutting down"}
+		return false, checks
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	checks["database"] = s.checkDatabase(ctx)
+	if checks["database"].OK {
+		checks["migrations"] = s.checkMigrations(ctx)
+	} else {
+		checks["migrations"] = Check{OK: false, Message: "database unavailable"}
+	}
+	checks["scheduler"] = s.checkScheduler()
+
+	ready := true
+	for _, c := range checks {
+		ready = ready && c.OK
+	}
+	return ready, checks
+}
+
+func (s *HealthService) checkDatabase(ctx context.Context) Check {
+	if err := s.db.Conn.PingContext(ctx); err != nil {
+		return Check{OK: false, Message: err.Error()}
+	}
+	return Check{OK: true}
+}
+
+func (s *HealthService) checkMigrations(ctx context.Context) Check {
+	expected, err := db.ExpectedVersion()
+	if err != nil {
+		return Check{OK: false, Message: err.Error()}
+	}
+	version, dirty, err := s.db.MigrationVersion(ctx)
+	if err != nil {
+		return Check{OK: false, Message: err.Error()}
+	}
+
+	details := map[string]interface{}{"version": version, "expected": expected, "dirty": dirty}
+	switch {
+	case dirty:
+		return Check{OK: false, Message: fmt.Sprintf("migration %d is dirty", version), Details: details}
+	case version < expected:
+		return Check{OK: false, Message: fmt.Sprintf("schema version %d is behind %d", version, expected), Details: details}
+	}
+	// Схема новее сборки - нормально при раскатке новой версии
+	return Check{OK: true, Details: details}
+}
+
+func (s *HealthService) checkScheduler() Check {
+	running, jobs := s.scheduler.Status(time.Now())
+	if !running {
+		return Check{OK: false, Message: "scheduler is not running", Details: jobs}
+	}
+	for _, job := range jobs {
+		if !job.Healthy {
+			return Check{OK: false, Message: fmt.Sprintf("job %s is stuck", job.Name), Details: jobs}
+		}
+	}
+	return Check{OK: true, Details: jobs}
+}
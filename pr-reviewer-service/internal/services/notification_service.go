@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/notify"
+	"pr-reviewer-service/internal/repositories"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Событий в очереди на отправку; при переполнении новые отбрасываются
+	notificationQueueSize = 256
+	// Время на одно сообщение, включая запросы в БД
+	notificationTimeout = 30 * time.Second
+)
+
+// NotificationService - сообщения о PR во входящие вебхуки команд (Slack/Mattermost).
+// События отправляются фоновым обработчиком: медленный чат не задерживает API
+type NotificationService struct {
+	repo      *repositories.NotificationRepository
+	prRepo    *repositories.PRRepository
+	templates *notify.Templates
+	sender    *notify.Sender
+	// digestAt - время ежедневного дайджеста от полуночи (часовой пояс сервера); < 0 - выключен
+	digestAt time.Duration
+
+	queue  chan models.Notification
+	done   chan struct{}
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewNotificationService - digestAt в формате HH:MM; пусто - без дайджеста
+func NewNotificationService(repo *repositories.NotificationRepository, prRepo *repositories.PRRepository,
+	templates *notify.Templates, sender *notify.Sender, digestAt string) (*NotificationService, error) {
+	s := &NotificationService{
+		repo:      repo,
+		prRepo:    prRepo,
+		templates: templates,
+		sender:    sender,
+		queue:     make(chan models.Notification, notificationQueueSize),
+		done:      make(chan struct{}),
+	}
+	var err error
+	if s.digestAt, err = parseDigestTime(digestAt); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseDigestTime - HH:MM в смещение от полуночи; пусто - -1 (дайджест выключен)
+func parseDigestTime(value string) (time.Duration, error) {
+	if value == "" {
+		return -1, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid digest time %q: %w", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// digestDue - наступило ли сегодня время дайджеста at
+func digestDue(now time.Time, at time.Duration) bool {
+	if at < 0 {
+		return false
+	}
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return !now.Before(midnight.Add(at))
+}
+
+// Start запускает отправку событий из очереди
+func (s *NotificationService) Start() {
+	go func() {
+		defer close(s.done)
+		for n := range s.queue {
+			ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+			if err := s.deliver(ctx, n); err != nil {
+				logger.Logger.Warn("Failed to send chat notification", zap.Error(err),
+					zap.String("event", n.Event), zap.Int("pr_id", n.PRID))
+			}
+			cancel()
+		}
+	}()
+}
+
+// Stop отправляет оставшиеся в очереди события и останавливает обработчик
+func (s *NotificationService) Stop() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+}
+
+// Notify ставит событие в очередь, не дожидаясь отправки. У nil-сервиса ничего не делает
+func (s *NotificationService) Notify(ctx context.Context, n models.Notification) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- n:
+	default:
+		logger.FromContext(ctx).Warn("Chat notification queue is full, dropping event",
+			zap.String("event", n.Event), zap.Int("pr_id", n.PRID))
+	}
+}
+
+func (s *NotificationService) deliver(ctx context.Context, n models.Notification) error {
+	pr, err := s.repo.GetPR(ctx, n.PRID)
+	if err != nil {
+		return err
+	}
+	if pr.WebhookURL == "" {
+		return nil
+	}
+
+	ids := append([]int{pr.AuthorID, n.OldReviewerID}, n.Recipients...)
+	users, err := s.repo.Users(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	msg := &notify.Message{
+		Event:       n.Event,
+		Team:        pr.TeamName,
+		PR:          notify.PR{ID: fmt.Sprintf("pr-%d", pr.ID), Title: pr.Title, Author: users[pr.AuthorID].Name},
+		OldReviewer: users[n.OldReviewerID].Name,
+		Policy:      n.Policy,
+		Hours:       n.Hours,
+	}
+	// Назначение - личное сообщение: отказавшиеся из него выпадают, а если адресатов
+	// не осталось, оно не отправляется. Нарушение SLA и слияние - для всей команды,
+	// отказавшиеся в них называются без упоминания
+	personal := n.Event == notify.EventAssigned || n.Event == notify.EventReassigned
+	for _, id := range n.Recipients {
+		u, ok := users[id]
+		if !ok || (personal && !u.Enabled) {
+			continue
+		}
+		msg.Recipients = append(msg.Recipients, notify.Person{Name: u.Name, Notify: u.Enabled})
+	}
+	if personal && len(msg.Recipients) == 0 {
+		return nil
+	}
+
+	text, err := s.templates.Render(msg.Event, msg)
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(ctx, pr.WebhookURL, text)
+}
+
+// SendDigest - раз в день после digestAt рассылает каждой команде с вебхуком открытые
+// ревью её PR по ревьюверам. Вызывается планировщиком. Команда отмечается до отправки,
+// чтобы экземпляры сервиса не дублировали сообщение; при ошибке отметка снимается, и
+// дайджест команде повторяется на следующем вызове
+func (s *NotificationService) SendDigest(ctx context.Context, now time.Time) error {
+	if !digestDue(now, s.digestAt) {
+		return nil
+	}
+
+	hooks, err := s.repo.TeamWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	day := now.Format("2006-01-02")
+	sent, failed := 0, 0
+	for _, hook := range hooks {
+		claimed, err := s.repo.ClaimDigest(ctx, models.DigestChannelChat, day, hook.TeamName)
+		if err != nil {
+			failed++
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.sendTeamDigest(ctx, hook, now); err != nil {
+			logger.FromContext(ctx).Warn("Failed to send review digest", zap.Error(err), zap.String("team_name", hook.TeamName))
+			// Ошибку снятия логирует репозиторий; тогда дайджест команде за день пропадёт
+			_ = s.repo.ReleaseDigest(ctx, models.DigestChannelChat, day, hook.TeamName)
+			failed++
+			continue
+		}
+		sent++
+	}
+	if failed > 0 {
+		return fmt.Errorf("review digest failed for %d of %d teams", failed, len(hooks))
+	}
+	if sent > 0 {
+		logger.FromContext(ctx).Info("Review digest sent", zap.Int("teams", sent))
+	}
+	return nil
+}
+
+func (s *NotificationService) sendTeamDigest(ctx context.Context, hook models.TeamWebhook, now time.Time) error {
+	// Открытые PR команды, сгруппированные по ревьюверам
+	byReviewer := make(map[int][]models.PullRequest)
+	ids := []int{}
+	filter := models.PRListFilter{Status: "OPEN", TeamName: hook.TeamName, Sort: models.PRSortCreatedAt, Limit: MaxPRPageSize}
+	for {
+		page, err := s.prRepo.ListPRs(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, pr := range page.PullRequests {
+			ids = append(ids, pr.AuthorID)
+			for _, reviewerID := range pr.AssignedReviewers {
+				byReviewer[reviewerID] = append(byReviewer[reviewerID], pr)
+				ids = append(ids, reviewerID)
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		filter.Cursor = page.NextCursor
+	}
+	if len(byReviewer) == 0 {
+		return nil
+	}
+
+	users, err := s.repo.Users(ctx, ids)
+	if err != nil {
+		return err
+	}
+	msg := &notify.Message{Event: notify.EventDigest, Team: hook.TeamName}
+	for reviewerID, prs := range byReviewer {
+		reviewer, ok := users[reviewerID]
+		if !ok || !reviewer.Enabled {
+			continue
+		}
+		entry := notify.DigestEntry{Reviewer: notify.Person{Name: reviewer.Name, Notify: true}}
+		for _, pr := range prs {
+			entry.PRs = append(entry.PRs, notify.PR{
+				ID:     fmt.Sprintf("pr-%d", pr.ID),
+				Title:  pr.Title,
+				Author: users[pr.AuthorID].Name,
+				Age:    notify.Age(now.Sub(pr.CreatedAt)),
+			})
+		}
+		msg.Reviews = append(msg.Reviews, entry)
+	}
+	if len(msg.Reviews) == 0 {
+		return nil
+	}
+	sort.Slice(msg.Reviews, func(i, j int) bool { return msg.Reviews[i].Reviewer.Name < msg.Reviews[j].Reviewer.Name })
+
+	text, err := s.templates.Render(msg.Event, msg)
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(ctx, hook.URL, text)
+}
+
+// SetTeamWebhook - входящий вебхук команды; пустой url выключает уведомления команды
+func (s *NotificationService) SetTeamWebhook(ctx context.Context, teamName, webhookURL string) error {
+	if webhookURL != "" {
+		u, err := url.Parse(webhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("BAD_REQUEST: url must be an absolute http(s) URL")
+		}
+	}
+	return s.repo.SetTeamWebhook(ctx, teamName, webhookURL)
+}
+
+func (s *NotificationService) SetUserNotifications(ctx context.Context, userID int, enabled bool) error {
+	return s.repo.SetUserNotifications(ctx, userID, enabled)
+}
@@ -1,12 +1,18 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"pr-reviewer-service/internal/logger"
 	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/notify"
 	"pr-reviewer-service/internal/repositories"
+	"pr-reviewer-service/internal/tracing"
 
-
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -14,63 +20,218 @@ type PRService struct {
 	prRepo   *repositories.PRRepository
 	userRepo *repositories.UserRepository
 	teamRepo *repositories.TeamRepository
+	notifier *NotificationService // nil - без уведомлений в чат
+}
+
+func NewPRService(prRepo *repositories.PRRepository, userRepo *repositories.UserRepository, teamRepo *repositories.TeamRepository, notifier *NotificationService) *PRService {
+	return &PRService{prRepo: prRepo, userRepo: userRepo, teamRepo: teamRepo, notifier: notifier}
 }
 
-func NewPRService(prRepo *repositories.PRRepository, userRepo *repositories.UserRepository, teamRepo *repositories.TeamRepository) *PRService {
-	return &PRService{prRepo: prRepo, userRepo: userRepo, teamRepo: teamRepo}
+func (s *PRService) CreatePR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string) (*models.PullRequest, error) {
+	return s.createPR(ctx, title, authorID, teamID, changedFiles, nil)
 }
 
-func (s *PRService) CreatePR(title string, authorID int, teamID int) (*models.PullRequest, error) {
-	logger.Logger.Info("Creating Pull Request", zap.String("title", title), zap.Int("author_id", authorID), zap.Int("team_id", teamID))
+// CreateLinkedPR - CreatePR для MR из внешней системы: PR и связь с MR создаются одной
+// транзакцией. Если MR уже связан, возвращается ошибка "PR_EXISTS: ..."
+func (s *PRService) CreateLinkedPR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string, link models.ExternalPR) (*models.PullRequest, error) {
+	return s.createPR(ctx, title, authorID, teamID, changedFiles, &link)
+}
+
+func (s *PRService) createPR(ctx context.Context, title string, authorID int, teamID int, changedFiles []string, link *models.ExternalPR) (pr *models.PullRequest, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.CreatePR",
+		attribute.Int("author_id", authorID), attribute.Int("team_id", teamID), attribute.Int("changed_files", len(changedFiles)))
+	defer func() { tracing.End(span, err) }()
+	log := logger.FromContext(ctx)
+
+	log.Info("Creating Pull Request", zap.String("title", title), zap.Int("author_id", authorID), zap.Int("team_id", teamID), zap.Int("changed_files", len(changedFiles)))
+
+	// Существование автора и команды проверяет репозиторий в той же транзакции
+	v := &models.ValidationError{}
+	if strings.TrimSpace(title) == "" {
+		v.Add("pull_request_name", "must not be blank")
+	}
+	if authorID <= 0 {
+		v.Add("author_id", "must be a positive id")
+	}
+	if teamID <= 0 {
+		v.Add("team_id", "must be a positive id")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
 
 	// 1. Создаём PR с ревьюверами через PRRepository
-	prID, err := s.prRepo.CreatePR(title, authorID, teamID)
+	prID, err := s.prRepo.CreatePR(ctx, title, authorID, teamID, changedFiles, link)
 	if err != nil {
-		logger.Logger.Error("Failed to create PR", zap.Error(err), zap.String("title", title), zap.Int("author_id", authorID))
+		log.Error("Failed to create PR", zap.Error(err), zap.String("title", title), zap.Int("author_id", authorID))
 		return nil, err
 	}
+	span.SetAttributes(attribute.Int("pr_id", prID))
 
 	// 2. Получаем полный объект PR
-	pr, err := s.prRepo.GetPR(prID)
+	pr, err = s.prRepo.GetPR(ctx, prID)
 	if err != nil {
-		logger.Logger.Error("Failed to fetch PR after creation", zap.Error(err), zap.Int("pr_id", prID))
+		log.Error("Failed to fetch PR after creation", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, err
 	}
 
-	logger.Logger.Info("Successfully created PR with reviewers", zap.Int("pr_id", prID), zap.Ints("reviewer_ids", pr.AssignedReviewers))
+	log.Info("Successfully created PR with reviewers", zap.Int("pr_id", prID), zap.Ints("reviewer_ids", pr.AssignedReviewers))
+	if len(pr.AssignedReviewers) > 0 {
+		s.notifier.Notify(ctx, models.Notification{Event: notify.EventAssigned, PRID: prID, Recipients: pr.AssignedReviewers})
+	}
 	return pr, nil
 }
 
+func (s *PRService) MergePR(ctx context.Context, prID int) (pr *models.PullRequest, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.MergePR", attribute.Int("pr_id", prID))
+	defer func() { tracing.End(span, err) }()
+	log := logger.FromContext(ctx)
 
+	log.Info("Merging Pull Request", zap.Int("pr_id", prID))
 
-func (s *PRService) MergePR(prID int) (*models.PullRequest, error) {
-	logger.Logger.Info("Merging Pull Request", zap.Int("pr_id", prID))
-
-	pr, err := s.prRepo.MergePR(prID)
+	// Повторный merge возвращает уже слитый PR; уведомляем, только если слили сейчас
+	// (merged_at хранится с точностью до микросекунд)
+	startedAt := time.Now().Truncate(time.Microsecond)
+	pr, err = s.prRepo.MergePR(ctx, prID)
 	if err != nil {
-		logger.Logger.Error("Failed to merge PR", zap.Error(err), zap.Int("pr_id", prID))
+		log.Error("Failed to merge PR", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, err
 	}
 
-	logger.Logger.Info("Successfully merged PR", zap.Int("pr_id", prID))
+	log.Info("Successfully merged PR", zap.Int("pr_id", prID))
+	if pr.MergedAt != nil && !pr.MergedAt.Before(startedAt) {
+		s.notifier.Notify(ctx, models.Notification{Event: notify.EventMerged, PRID: prID, Recipients: pr.AssignedReviewers})
+	}
 	return pr, nil
 }
 
-func (s *PRService) ReassignReviewer(prID int, oldReviewerID int) (*models.PullRequest, int, error) {
-	logger.Logger.Info("Reassigning reviewer", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+func (s *PRService) ReassignReviewer(ctx context.Context, prID int, oldReviewerID int) (pr *models.PullRequest, newReviewerID int, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.ReassignReviewer",
+		attribute.Int("pr_id", prID), attribute.Int("old_reviewer_id", oldReviewerID))
+	defer func() { tracing.End(span, err) }()
+	log := logger.FromContext(ctx)
+
+	log.Info("Reassigning reviewer", zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+
+	newReviewerID, err = s.prRepo.ReassignReviewer(ctx, prID, oldReviewerID)
+	if err != nil {
+		log.Error("Failed to reassign reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		return nil, 0, err
+	}
+
+	pr, err = s.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		log.Error("Failed to fetch PR after reassigning reviewer", zap.Error(err), zap.Int("pr_id", prID))
+		return nil, 0, err
+	}
+
+	log.Info("Successfully reassigned reviewer", zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+	s.notifier.Notify(ctx, models.Notification{Event: notify.EventReassigned, PRID: prID,
+		Recipients: []int{newReviewerID}, OldReviewerID: oldReviewerID})
+	return pr, newReviewerID, nil
+}
+
+func (s *PRService) AddReviewer(ctx context.Context, prID int) (pr *models.PullRequest, newReviewerID int, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.AddReviewer", attribute.Int("pr_id", prID))
+	defer func() { tracing.End(span, err) }()
+	log := logger.FromContext(ctx)
 
-	newReviewerID, err := s.prRepo.ReassignReviewer(prID, oldReviewerID)
+	log.Info("Adding reviewer", zap.Int("pr_id", prID))
+
+	newReviewerID, err = s.prRepo.AddReviewer(ctx, prID)
 	if err != nil {
-		logger.Logger.Error("Failed to reassign reviewer", zap.Error(err), zap.Int("pr_id", prID), zap.Int("old_reviewer_id", oldReviewerID))
+		log.Error("Failed to add reviewer", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, 0, err
 	}
 
-	pr, err := s.prRepo.GetPR(prID)
+	pr, err = s.prRepo.GetPR(ctx, prID)
 	if err != nil {
-		logger.Logger.Error("Failed to fetch PR after reassigning reviewer", zap.Error(err), zap.Int("pr_id", prID))
+		log.Error("Failed to fetch PR after adding reviewer", zap.Error(err), zap.Int("pr_id", prID))
 		return nil, 0, err
 	}
 
-	logger.Logger.Info("Successfully reassigned reviewer", zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+	log.Info("Successfully added reviewer", zap.Int("pr_id", prID), zap.Int("new_reviewer_id", newReviewerID))
+	s.notifier.Notify(ctx, models.Notification{Event: notify.EventAssigned, PRID: prID, Recipients: []int{newReviewerID}})
 	return pr, newReviewerID, nil
 }
+
+// ReassignOpenReviews - передаёт все OPEN ревью пользователя другим членам команд PR
+// (при деактивации). PR без кандидата остаются на пользователе и попадут под SLA
+func (s *PRService) ReassignOpenReviews(ctx context.Context, reviewerID int) (reassigned, failed int, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.ReassignOpenReviews", attribute.Int("reviewer_id", reviewerID))
+	defer func() { tracing.End(span, err) }()
+	log := logger.FromContext(ctx)
+
+	prIDs, err := s.prRepo.OpenReviewPRIDs(ctx, reviewerID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, prID := range prIDs {
+		if _, _, err := s.ReassignReviewer(ctx, prID, reviewerID); err != nil {
+			log.Warn("Failed to reassign review of deactivated user", zap.Error(err), zap.Int("pr_id", prID), zap.Int("reviewer_id", reviewerID))
+			failed++
+			continue
+		}
+		reassigned++
+	}
+
+	log.Info("Reassigned open reviews", zap.Int("reviewer_id", reviewerID), zap.Int("reassigned", reassigned), zap.Int("failed", failed))
+	return reassigned, failed, nil
+}
+
+func (s *PRService) ListUnderstaffed(ctx context.Context, teamName string) (prs []models.PullRequest, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.ListUnderstaffed", attribute.String("team_name", teamName))
+	defer func() { tracing.End(span, err) }()
+
+	return s.prRepo.ListUnderstaffed(ctx, teamName)
+}
+
+// Размер страницы списка PR; MaxPRPageSize нужен и GraphQL для оценки сложности запроса
+const (
+	defaultPRPageSize = 20
+	MaxPRPageSize     = 100
+)
+
+// ListPRs - страница PR по фильтру. По умолчанию - новые сверху
+func (s *PRService) ListPRs(ctx context.Context, filter models.PRListFilter) (page *models.PRListPage, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.ListPRs")
+	defer func() { tracing.End(span, err) }()
+
+	if filter.Sort == "" {
+		filter.Sort = models.PRSortCreatedAt
+		filter.Desc = true
+	}
+	if err := validatePRListFilter(&filter); err != nil {
+		return nil, err
+	}
+	return s.prRepo.ListPRs(ctx, filter)
+}
+
+// validatePRListFilter проверяет статус, сортировку и лимит; нулевой лимит - размер по умолчанию
+func validatePRListFilter(filter *models.PRListFilter) error {
+	switch filter.Status {
+	case "", "OPEN", "MERGED":
+	default:
+		return fmt.Errorf("BAD_REQUEST: unknown status %q", filter.Status)
+	}
+	switch filter.Sort {
+	case models.PRSortCreatedAt, models.PRSortMergedAt, models.PRSortTitle, models.PRSortStatus, models.PRSortID:
+	default:
+		return fmt.Errorf("BAD_REQUEST: unknown sort field %q", filter.Sort)
+	}
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPRPageSize
+	case filter.Limit < 0 || filter.Limit > MaxPRPageSize:
+		return fmt.Errorf("BAD_REQUEST: limit must be between 1 and %d", MaxPRPageSize)
+	}
+	return nil
+}
+
+func (s *PRService) GetPRDetails(ctx context.Context, prID int) (details *models.PRDetails, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PRService.GetPRDetails", attribute.Int("pr_id", prID))
+	defer func() { tracing.End(span, err) }()
+
+	return s.prRepo.GetPRDetails(ctx, prID)
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type ScimService struct {
+	repo      *repositories.ScimRepository
+	prService *PRService
+}
+
+func NewScimService(repo *repositories.ScimRepository, prService *PRService) *ScimService {
+	return &ScimService{repo: repo, prService: prService}
+}
+
+func (s *ScimService) GetUser(ctx context.Context, id int) (*models.ScimUser, error) {
+	return s.repo.GetUser(ctx, id)
+}
+
+func (s *ScimService) ListUsers(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimUser, int, error) {
+	return s.repo.ListUsers(ctx, filter, offset, limit)
+}
+
+func (s *ScimService) CreateUser(ctx context.Context, u *models.ScimUser) error {
+	if u.UserName == "" {
+		return fmt.Errorf("BAD_REQUEST: userName is required")
+	}
+	return s.repo.CreateUser(ctx, u)
+}
+
+// ReplaceUser - PUT: атрибуты заменяются целиком. Деактивация (active true -> false)
+// передаёт открытые ревью пользователя другим
+func (s *ScimService) ReplaceUser(ctx context.Context, u *models.ScimUser) (*models.ScimUser, error) {
+	if u.UserName == "" {
+		return nil, fmt.Errorf("BAD_REQUEST: userName is required")
+	}
+	wasActive, err := s.repo.UpdateUser(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if wasActive && !u.Active {
+		s.deprovisioned(ctx, u.ID)
+	}
+	return s.repo.GetUser(ctx, u.ID)
+}
+
+// PatchUser - PATCH: поддерживаются active, userName и externalId; прочие атрибуты
+// (name, emails, ...) сервис не хранит и молча пропускает
+func (s *ScimService) PatchUser(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimUser, error) {
+	u, err := s.repo.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range ops {
+		kind := strings.ToLower(op.Op)
+		if kind != "add" && kind != "replace" && kind != "remove" {
+			return nil, fmt.Errorf("BAD_REQUEST: unsupported patch op %q", op.Op)
+		}
+		values, err := patchValues(op)
+		if err != nil {
+			return nil, err
+		}
+		for attr, raw := range values {
+			switch attr {
+			case "active":
+				if kind == "remove" {
+					return nil, fmt.Errorf("BAD_REQUEST: active cannot be removed")
+				}
+				if u.Active, err = models.ParseScimBool(raw); err != nil {
+					return nil, fmt.Errorf("BAD_REQUEST: active: %v", err)
+				}
+			case "username":
+				if kind == "remove" {
+					return nil, fmt.Errorf("BAD_REQUEST: userName cannot be removed")
+				}
+				if err := json.Unmarshal(raw, &u.UserName); err != nil {
+					return nil, fmt.Errorf("BAD_REQUEST: userName must be a string")
+				}
+			case "externalid":
+				u.ExternalID = ""
+				if kind != "remove" {
+					if err := json.Unmarshal(raw, &u.ExternalID); err != nil {
+						return nil, fmt.Errorf("BAD_REQUEST: externalId must be a string")
+					}
+				}
+			}
+		}
+	}
+	return s.ReplaceUser(ctx, u)
+}
+
+// DeleteUser - деактивация, исключение из команд и переназначение открытых ревью
+func (s *ScimService) DeleteUser(ctx context.Context, id int) error {
+	wasActive, err := s.repo.DeleteUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if wasActive {
+		s.deprovisioned(ctx, id)
+	}
+	return nil
+}
+
+// deprovisioned - ошибки переназначения не отменяют деактивацию: она уже применена
+func (s *ScimService) deprovisioned(ctx context.Context, userID int) {
+	if _, _, err := s.prService.ReassignOpenReviews(ctx, userID); err != nil {
+		logger.FromContext(ctx).Error("Failed to reassign reviews of deprovisioned user", zap.Error(err), zap.Int("user_id", userID))
+	}
+}
+
+func (s *ScimService) GetGroup(ctx context.Context, id int) (*models.ScimGroup, error) {
+	return s.repo.GetGroup(ctx, id)
+}
+
+func (s *ScimService) ListGroups(ctx context.Context, filter *models.ScimFilter, offset, limit int) ([]models.ScimGroup, int, error) {
+	return s.repo.ListGroups(ctx, filter, offset, limit)
+}
+
+func (s *ScimService) CreateGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error) {
+	if g.DisplayName == "" {
+		return nil, fmt.Errorf("BAD_REQUEST: displayName is required")
+	}
+	if err := s.repo.CreateGroup(ctx, g); err != nil {
+		return nil, err
+	}
+	return s.repo.GetGroup(ctx, g.ID)
+}
+
+func (s *ScimService) ReplaceGroup(ctx context.Context, g *models.ScimGroup) (*models.ScimGroup, error) {
+	if g.DisplayName == "" {
+		return nil, fmt.Errorf("BAD_REQUEST: displayName is required")
+	}
+	if err := s.repo.UpdateGroup(ctx, g); err != nil {
+		return nil, err
+	}
+	return s.repo.GetGroup(ctx, g.ID)
+}
+
+// memberFilterPath - `members[value eq "3"]`, так IdP удаляют одного участника
+var memberFilterPath = regexp.MustCompile(`(?i)^members\[value eq "([^"]+)"\]$`)
+
+// PatchGroup - PATCH: displayName, externalId и members (add/remove/replace)
+func (s *ScimService) PatchGroup(ctx context.Context, id int, ops []models.ScimPatchOp) (*models.ScimGroup, error) {
+	g, err := s.repo.GetGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members := map[int]bool{}
+	for _, m := range g.Members {
+		members[m.ID] = true
+	}
+
+	for _, op := range ops {
+		kind := strings.ToLower(op.Op)
+		if kind != "add" && kind != "replace" && kind != "remove" {
+			return nil, fmt.Errorf("BAD_REQUEST: unsupported patch op %q", op.Op)
+		}
+
+		if m := memberFilterPath.FindStringSubmatch(op.Path); m != nil {
+			if kind != "remove" {
+				return nil, fmt.Errorf("BAD_REQUEST: only remove is supported for %q", op.Path)
+			}
+			memberID, err := models.ParseScimID(m[1])
+			if err != nil {
+				return nil, fmt.Errorf("BAD_REQUEST: %v", err)
+			}
+			delete(members, memberID)
+			continue
+		}
+
+		values, err := patchValues(op)
+		if err != nil {
+			return nil, err
+		}
+		for attr, raw := range values {
+			switch attr {
+			case "displayname":
+				if kind == "remove" {
+					return nil, fmt.Errorf("BAD_REQUEST: displayName cannot be removed")
+				}
+				if err := json.Unmarshal(raw, &g.DisplayName); err != nil {
+					return nil, fmt.Errorf("BAD_REQUEST: displayName must be a string")
+				}
+			case "externalid":
+				g.ExternalID = ""
+				if kind != "remove" {
+					if err := json.Unmarshal(raw, &g.ExternalID); err != nil {
+						return nil, fmt.Errorf("BAD_REQUEST: externalId must be a string")
+					}
+				}
+			case "members":
+				refs, err := models.ParseScimRefs(raw)
+				if err != nil {
+					return nil, fmt.Errorf("BAD_REQUEST: members: %v", err)
+				}
+				switch {
+				case kind == "replace":
+					members = map[int]bool{}
+					fallthrough
+				case kind == "add":
+					for _, ref := range refs {
+						members[ref.ID] = true
+					}
+				case len(raw) == 0 || string(raw) == "null":
+					// remove без value - убрать всех
+					members = map[int]bool{}
+				default:
+					for _, ref := range refs {
+						delete(members, ref.ID)
+					}
+				}
+			}
+		}
+	}
+
+	g.Members = g.Members[:0]
+	for memberID := range members {
+		g.Members = append(g.Members, models.ScimRef{ID: memberID})
+	}
+	return s.ReplaceGroup(ctx, g)
+}
+
+func (s *ScimService) DeleteGroup(ctx context.Context, id int) error {
+	return s.repo.DeleteGroup(ctx, id)
+}
+
+// patchValues - атрибуты операции (в нижнем регистре): либо path + value,
+// либо без path и объект в value
+func patchValues(op models.ScimPatchOp) (map[string]json.RawMessage, error) {
+	if op.Path != "" {
+		return map[string]json.RawMessage{strings.ToLower(op.Path): op.Value}, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(op.Value, &obj); err != nil {
+		return nil, fmt.Errorf("BAD_REQUEST: patch without path needs an object value")
+	}
+	values := make(map[string]json.RawMessage, len(obj))
+	for k, v := range obj {
+		values[strings.ToLower(k)] = v
+	}
+	return values, nil
+}
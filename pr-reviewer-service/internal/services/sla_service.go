@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"pr-reviewer-service/internal/logger"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/notify"
+	"pr-reviewer-service/internal/repositories"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type SLAService struct {
+	slaRepo   *repositories.SLARepository
+	prService *PRService
+	notifier  *NotificationService // nil - без уведомлений в чат
+}
+
+func NewSLAService(slaRepo *repositories.SLARepository, prService *PRService, notifier *NotificationService) *SLAService {
+	return &SLAService{slaRepo: slaRepo, prService: prService, notifier: notifier}
+}
+
+func (s *SLAService) SetTeamSLA(ctx context.Context, sla *models.TeamSLA) error {
+	if sla.Policy == "" {
+		sla.Policy = models.SLAPolicyEscalate
+	}
+	switch sla.Policy {
+	case models.SLAPolicyEscalate, models.SLAPolicyAddReviewer, models.SLAPolicyReassign:
+	default:
+		return fmt.Errorf("BAD_REQUEST: unknown SLA policy %q", sla.Policy)
+	}
+	if sla.FirstResponseHours <= 0 {
+		return fmt.Errorf("BAD_REQUEST: first_response_hours must be positive")
+	}
+	return s.slaRepo.SetTeamSLA(ctx, sla)
+}
+
+func (s *SLAService) GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error) {
+	return s.slaRepo.GetTeamSLA(ctx, teamName)
+}
+
+// CheckOverdue - находит просроченные ревью и применяет к ним политику команды.
+// Вызывается планировщиком из cmd/app
+func (s *SLAService) CheckOverdue(ctx context.Context, now time.Time) error {
+	pending, err := s.slaRepo.GetOverdueReviews(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	// SLA считается только в рабочих часах ревьювера
+	var overdue []models.OverdueReview
+	for _, o := range pending {
+		if o.IsOverdue(now) {
+			overdue = append(overdue, o)
+		}
+	}
+	if len(overdue) == 0 {
+		return nil
+	}
+	logger.FromContext(ctx).Info("Found overdue reviews", zap.Int("count", len(overdue)))
+
+	// Для ADD_REVIEWER добавляем не больше одного ревьювера на PR за проход
+	extended := make(map[int]bool)
+	failed := 0
+	for _, o := range overdue {
+		// action - что сделано на самом деле: если политика не сработала, это эскалация
+		action := models.SLAPolicyEscalate
+		newReviewerID := 0
+		switch o.Policy {
+		case models.SLAPolicyAddReviewer:
+			// Остальные просрочившие ревьюверы PR не отмечаются: их обработает следующий проход
+			if extended[o.PRID] {
+				continue
+			}
+			_, id, err := s.prService.AddReviewer(ctx, o.PRID)
+			if err != nil {
+				logger.FromContext(ctx).Warn("SLA: failed to add reviewer, escalating instead", zap.Error(err), zap.Int("pr_id", o.PRID))
+				break
+			}
+			extended[o.PRID] = true
+			action, newReviewerID = o.Policy, id
+		case models.SLAPolicyReassign:
+			_, id, err := s.prService.ReassignReviewer(ctx, o.PRID, o.ReviewerID)
+			if err != nil {
+				logger.FromContext(ctx).Warn("SLA: failed to reassign idle reviewer, escalating instead", zap.Error(err),
+					zap.Int("pr_id", o.PRID), zap.Int("reviewer_id", o.ReviewerID))
+				break
+			}
+			action, newReviewerID = o.Policy, id
+		}
+
+		// Без записи ревью попадёт в следующий проход; остальные обрабатываем дальше
+		if err := s.slaRepo.RecordEscalation(ctx, o.PRID, o.ReviewerID, action, newReviewerID); err != nil {
+			logger.FromContext(ctx).Error("SLA: failed to record escalation", zap.Error(err),
+				zap.Int("pr_id", o.PRID), zap.Int("reviewer_id", o.ReviewerID), zap.String("action", action))
+			failed++
+			continue
+		}
+		logger.FromContext(ctx).Warn("Review SLA breached",
+			zap.Int("pr_id", o.PRID),
+			zap.Int("reviewer_id", o.ReviewerID),
+			zap.Time("assigned_at", o.AssignedAt),
+			zap.Int("first_response_hours", o.FirstResponseHours),
+			zap.String("policy", o.Policy),
+			zap.String("action", action),
+			zap.Int("new_reviewer_id", newReviewerID),
+		)
+		// О новом ревьювере (ADD_REVIEWER/REASSIGN) сообщает PRService
+		s.notifier.Notify(ctx, models.Notification{Event: notify.EventSLABreach, PRID: o.PRID,
+			Recipients: []int{o.ReviewerID}, Policy: action, Hours: o.FirstResponseHours})
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to record %d of %d SLA escalations", failed, len(overdue))
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"pr-reviewer-service/internal/models"
+	"pr-reviewer-service/internal/repositories"
+)
+
+type StatsService struct {
+	repo *repositories.StatsRepository
+}
+
+func NewStatsService(repo *repositories.StatsRepository) *StatsService {
+	return &StatsService{repo: repo}
+}
+
+func (s *StatsService) GetStats(ctx context.Context) (*models.ReviewStats, error) {
+	return s.repo.GetStats(ctx)
+}
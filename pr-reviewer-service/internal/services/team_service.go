@@ -1,8 +1,11 @@
 package services
 
 import (
+	"context"
+	"fmt"
 	"pr-reviewer-service/internal/models"
 	"pr-reviewer-service/internal/repositories"
+	"strings"
 )
 
 type TeamService struct {
@@ -13,10 +16,52 @@ func NewTeamService(repo *repositories.TeamRepository) *TeamService {
 	return &TeamService{repo: repo}
 }
 
-func (s *TeamService) AddTeam(team *models.Team) error {
-	return s.repo.CreateTeam(team)
+func (s *TeamService) AddTeam(ctx context.Context, team *models.Team) error {
+	if err := validateTeam(team); err != nil {
+		return err
+	}
+	return s.repo.CreateTeam(ctx, team)
 }
 
-func (s *TeamService) GetTeam(name string) (*models.Team, error) {
-	return s.repo.GetTeam(name)
+// validateTeam - имя команды и участников не пустые, ID участников не повторяются
+// ("u3" и "3" - один и тот же пользователь)
+func validateTeam(team *models.Team) error {
+	v := &models.ValidationError{}
+	if strings.TrimSpace(team.TeamName) == "" {
+		v.Add("team_name", "must not be blank")
+	}
+	seen := make(map[int]int, len(team.Members))
+	for i, m := range team.Members {
+		field := fmt.Sprintf("members[%d]", i)
+		if m.UserID <= 0 {
+			v.Add(field+".user_id", "must be a positive id")
+		} else if first, ok := seen[m.UserID]; ok {
+			v.Add(field+".user_id", fmt.Sprintf("duplicates members[%d].user_id", first))
+		} else {
+			seen[m.UserID] = i
+		}
+		if strings.TrimSpace(m.Username) == "" {
+			v.Add(field+".username", "must not be blank")
+		}
+	}
+	return v.Err()
+}
+
+func (s *TeamService) GetTeam(ctx context.Context, name string) (*models.Team, error) {
+	return s.repo.GetTeam(ctx, name)
+}
+
+func (s *TeamService) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, limit *int) error {
+	if limit != nil && *limit < 0 {
+		return fmt.Errorf("BAD_REQUEST: default_max_open_reviews must not be negative")
+	}
+	return s.repo.SetDefaultMaxOpenReviews(ctx, teamName, limit)
+}
+
+func (s *TeamService) ListTeams(ctx context.Context) ([]models.TeamSummary, error) {
+	return s.repo.ListTeams(ctx)
+}
+
+func (s *TeamService) RemoveMember(ctx context.Context, teamName string, userID int) error {
+	return s.repo.RemoveMember(ctx, teamName, userID)
 }
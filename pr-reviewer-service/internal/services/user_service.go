@@ -1,6 +1,9 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"net/mail"
 	"pr-reviewer-service/internal/models"
 	"pr-reviewer-service/internal/repositories"
 )
@@ -14,10 +17,101 @@ func NewUserService(userRepo *repositories.UserRepository, prRepo *repositories.
 	return &UserService{userRepo: userRepo, prRepo: prRepo}
 }
 
-func (s *UserService) SetIsActive(userID int, isActive bool) (*models.User, error) {
-	return s.userRepo.SetIsActive(userID, isActive)
+func (s *UserService) SetIsActive(ctx context.Context, userID int, isActive bool) (*models.User, error) {
+	return s.userRepo.SetIsActive(ctx, userID, isActive)
 }
 
-func (s *UserService) GetReview(userID int) ([]models.PullRequest, error) {
-	return s.userRepo.GetAssignedPRs(userID)
+// GetReview - PR, где пользователь назначен ревьювером. Порядок стабильный, по умолчанию
+// по id. Без limit и cursor возвращаются все PR одним ответом, как до пагинации:
+// старые клиенты не должны молча терять PR за первой страницей
+func (s *UserService) GetReview(ctx context.Context, userID int, filter models.PRListFilter) (*models.PRListPage, error) {
+	filter.ReviewerID = userID
+	if filter.Sort == "" {
+		filter.Sort = models.PRSortID
+	}
+	all := filter.Limit == 0 && filter.Cursor == ""
+	if filter.Limit == 0 {
+		filter.Limit = MaxPRPageSize
+	}
+	if err := validatePRListFilter(&filter); err != nil {
+		return nil, err
+	}
+	if !all {
+		return s.prRepo.ListPRs(ctx, filter)
+	}
+
+	result := &models.PRListPage{}
+	for {
+		page, err := s.prRepo.ListPRs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		result.PullRequests = append(result.PullRequests, page.PullRequests...)
+		if page.NextCursor == "" {
+			return result, nil
+		}
+		filter.Cursor = page.NextCursor
+	}
+}
+
+func (s *UserService) AddUnavailability(ctx context.Context, u *models.Unavailability) error {
+	if u.StartsAt.IsZero() || u.EndsAt.IsZero() {
+		return fmt.Errorf("BAD_REQUEST: starts_at and ends_at are required")
+	}
+	if !u.EndsAt.After(u.StartsAt) {
+		return fmt.Errorf("BAD_REQUEST: ends_at must be after starts_at")
+	}
+	return s.userRepo.AddUnavailability(ctx, u)
+}
+
+func (s *UserService) ListUnavailability(ctx context.Context, userID int) ([]models.Unavailability, error) {
+	return s.userRepo.ListUnavailability(ctx, userID)
+}
+
+func (s *UserService) DeleteUnavailability(ctx context.Context, id int) error {
+	return s.userRepo.DeleteUnavailability(ctx, id)
+}
+
+func (s *UserService) SetSchedule(ctx context.Context, sch *models.WorkSchedule) error {
+	// Незаполненные поля берём из расписания по умолчанию
+	def := models.DefaultWorkSchedule()
+	if sch.TimeZone == "" {
+		sch.TimeZone = def.TimeZone
+	}
+	if sch.WorkStart == "" {
+		sch.WorkStart = def.WorkStart
+	}
+	if sch.WorkEnd == "" {
+		sch.WorkEnd = def.WorkEnd
+	}
+	if len(sch.WorkDays) == 0 {
+		sch.WorkDays = def.WorkDays
+	}
+	if err := sch.Validate(); err != nil {
+		return fmt.Errorf("BAD_REQUEST: %w", err)
+	}
+	return s.userRepo.SetSchedule(ctx, sch)
+}
+
+func (s *UserService) GetSchedule(ctx context.Context, userID int) (*models.WorkSchedule, error) {
+	return s.userRepo.GetSchedule(ctx, userID)
+}
+
+func (s *UserService) SetMaxOpenReviews(ctx context.Context, userID int, limit *int) error {
+	if limit != nil && *limit < 0 {
+		return fmt.Errorf("BAD_REQUEST: max_open_reviews must not be negative")
+	}
+	return s.userRepo.SetMaxOpenReviews(ctx, userID, limit)
+}
+
+// SetEmail - адрес для email-дайджеста; пустой удаляет его
+func (s *UserService) SetEmail(ctx context.Context, userID int, email string) error {
+	if email != "" {
+		// Только сам адрес, без имени и угловых скобок
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email {
+			return fmt.Errorf("BAD_REQUEST: invalid email address")
+		}
+	}
+	return s.userRepo.SetEmail(ctx, userID, email)
 }